@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/codebase-interface/cli/internal/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	configShowPath       string
+	configShowFormat     string
+	configShowProvenance bool
+	configShowSets       []string
+	configShowFile       string
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration used for validation",
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the effective configuration",
+	Long: `Print the configuration validate would use for a path: the defaults with
+the configuration file and everything it extends merged on top, followed by
+CBI_ environment variables and --set overrides.
+
+With --provenance, every setting is annotated with where its value came from:
+"default", a configuration file, a preset, an environment variable or --set.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigShow,
+}
+
+func runConfigShow(cmd *cobra.Command, args []string) error {
+	if configShowFormat != "yaml" && configShowFormat != "json" {
+		return &ExitError{Code: ExitConfigError, Err: fmt.Errorf("unsupported format: %s", configShowFormat)}
+	}
+
+	cfg, provenance, _, err := loadConfig(configShowPath, configShowFile, configShowSets)
+	if err != nil {
+		return &ExitError{Code: ExitConfigError, Err: fmt.Errorf("failed to load configuration: %w", err)}
+	}
+
+	document, err := config.Effective(cfg)
+	if err != nil {
+		return &ExitError{Code: ExitInternalError, Err: err}
+	}
+
+	if configShowFormat == "json" {
+		var settings interface{}
+		if err := document.Decode(&settings); err != nil {
+			return &ExitError{Code: ExitInternalError, Err: err}
+		}
+
+		var report interface{} = settings
+		if configShowProvenance {
+			report = map[string]interface{}{
+				"config":     settings,
+				"provenance": provenance.Sources(document),
+			}
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return &ExitError{Code: ExitInternalError, Err: err}
+		}
+		return nil
+	}
+
+	if configShowProvenance {
+		provenance.Annotate(document)
+	}
+
+	encoder := yaml.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return &ExitError{Code: ExitInternalError, Err: err}
+	}
+	return encoder.Close()
+}
+
+// loadConfig loads the configuration file, or the one discovered from path
+// when file is empty, and applies the CBI_ environment variables and then the
+// --set values, so flags win. It also returns the file used, which is empty
+// when the defaults apply.
+func loadConfig(path, file string, sets []string) (*config.Config, config.Provenance, string, error) {
+	if file == "" {
+		var err error
+		if file, err = config.Discover(path); err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	cfg, provenance := config.DefaultConfig(), config.Provenance{}
+	if file != "" {
+		var err error
+		if cfg, provenance, err = config.LoadFileWithProvenance(file); err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	envOverrides, err := config.EnvOverrides(os.Environ())
+	if err != nil {
+		return nil, nil, "", err
+	}
+	setOverrides, err := config.ParseSetFlags(sets)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	cfg, err = config.ApplyOverrides(cfg, provenance, append(envOverrides, setOverrides...))
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return cfg, provenance, file, nil
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configShowCmd)
+
+	configShowCmd.Flags().StringVarP(&configShowPath, "path", "p", ".", "Path whose configuration to show")
+	configShowCmd.Flags().StringVarP(&configShowFormat, "format", "f", "yaml", "Output format (yaml, json)")
+	configShowCmd.Flags().BoolVar(&configShowProvenance, "provenance", false, "Annotate each setting with where its value came from")
+	configShowCmd.Flags().StringVarP(&configShowFile, "config", "c", "", "Configuration file to use instead of the one discovered from --path")
+	configShowCmd.Flags().StringArrayVar(&configShowSets, "set", nil, "Override a setting, e.g. validation.output.verbose=true (repeatable)")
+}
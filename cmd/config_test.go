@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig_EnvOverrides(t *testing.T) {
+	t.Setenv("CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPTH", "50")
+
+	cfg, provenance, _, err := loadConfig(t.TempDir(), "", nil)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if depth := cfg.Validation.Agents.DevelopmentStandards.CommitHistoryDepth; depth != 50 {
+		t.Errorf("Expected commit history depth 50, got %d", depth)
+	}
+	if source := provenance.Source("validation.agents.development-standards.commit_history_depth"); source != "env:CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPTH" {
+		t.Errorf("Expected the variable as provenance, got %s", source)
+	}
+}
+
+func TestConfigShow_UnknownEnvVariable(t *testing.T) {
+	t.Setenv("CBI_VALIDATION_OUTPUT_VERBOSITY", "true")
+
+	_, _, _, err := loadConfig(t.TempDir(), "", nil)
+	if err == nil || !strings.Contains(err.Error(), "CBI_VALIDATION_OUTPUT_VERBOSITY does not name a setting") {
+		t.Errorf("Expected an error naming the variable, got %v", err)
+	}
+
+	rootCmd.SetArgs([]string{"config", "show", "--path", t.TempDir()})
+	defer rootCmd.SetArgs(nil)
+
+	if code := ExitCode(Execute()); code != ExitConfigError {
+		t.Errorf("Expected exit code %d, got %d", ExitConfigError, code)
+	}
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Exit codes of the CLI.
+const (
+	ExitPass             = 0 // validation passed
+	ExitValidationFailed = 1 // validation ran and the codebase did not pass
+	ExitConfigError      = 2 // the configuration or command line is invalid
+	ExitInternalError    = 3 // an agent could not run or the tool itself failed
+)
+
+// ExitError is returned by commands that end with a specific exit code. Err
+// is nil when the command has already reported the outcome itself, as
+// validate does with its report.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code for an error returned by Execute. Errors
+// without an ExitError are unexpected failures of the tool itself.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitPass
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return ExitInternalError
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "no error", err: nil, expected: ExitPass},
+		{name: "exit error", err: &ExitError{Code: ExitValidationFailed}, expected: ExitValidationFailed},
+		{name: "wrapped exit error", err: fmt.Errorf("run: %w", &ExitError{Code: ExitConfigError}), expected: ExitConfigError},
+		{name: "unknown error", err: errors.New("boom"), expected: ExitInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := ExitCode(tt.err); code != tt.expected {
+				t.Errorf("Expected exit code %d, got %d", tt.expected, code)
+			}
+		})
+	}
+}
+
+func TestExecute_UsageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "unknown flag", args: []string{"validate", "--no-such-flag"}},
+		{name: "too many arguments", args: []string{"init-config", "basic", "strict"}},
+		{name: "unknown command", args: []string{"no-such-command"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootCmd.SetArgs(tt.args)
+			defer rootCmd.SetArgs(nil)
+
+			if code := ExitCode(Execute()); code != ExitConfigError {
+				t.Errorf("Expected exit code %d, got %d", ExitConfigError, code)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_ExitCodes(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.yml")
+	if err := os.WriteFile(invalid, []byte("version: \"1.0\"\nno_such_key: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "missing file", path: filepath.Join(dir, "missing.yml")},
+		{name: "no configuration in directory", path: dir},
+		{name: "invalid configuration", path: invalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootCmd.SetArgs([]string{"validate-config", tt.path})
+			defer rootCmd.SetArgs(nil)
+
+			if code := ExitCode(Execute()); code != ExitConfigError {
+				t.Errorf("Expected exit code %d, got %d", ExitConfigError, code)
+			}
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/spf13/cobra"
 )
 
@@ -10,11 +17,33 @@ var rootCmd = &cobra.Command{
 	Short:   "A CLI for validating codebase structure and standards",
 	Long: `Codebase Interface CLI validates essential files and configurations for proper codebase setup.
 It checks for README.md, CONTRIBUTING.md, Git configuration files, and development standards.`,
+	// Errors are printed by Execute, which knows which ones have already
+	// been reported.
+	SilenceErrors: true,
+	// Cobra runs this once the flags and arguments are valid, so errors
+	// from here on are not usage mistakes.
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		cmd.SilenceUsage = true
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
+// Commands run with a context that is cancelled on Ctrl-C or SIGTERM. Pass
+// the returned error to ExitCode to get the process exit code.
 func Execute() error {
-	return rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	var exitErr *ExitError
+	if err != nil && !errors.As(err, &exitErr) && !cmd.SilenceUsage {
+		// Cobra rejected the command line before the command ran.
+		err = &ExitError{Code: ExitConfigError, Err: err}
+	}
+	if err != nil && !(errors.As(err, &exitErr) && exitErr.Err == nil) {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+	}
+	return err
 }
 
 func init() {
@@ -1,16 +1,13 @@
 package cmd
 
 import (
-	"embed"
 	"fmt"
 	"os"
 
+	"github.com/codebase-interface/cli/cmd/schema"
 	"github.com/spf13/cobra"
 )
 
-//go:embed schema/codebase-validation.schema.json
-var schemaFS embed.FS
-
 var schemaCmd = &cobra.Command{
 	Use:   "schema",
 	Short: "Display or save the JSON schema for configuration files",
@@ -19,20 +16,16 @@ for .codebase-validation.yml configuration files.
 
 The schema can be used with editors that support JSON Schema for autocompletion
 and validation while editing YAML configuration files.`,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		outputFile, _ := cmd.Flags().GetString("output")
 
-		schemaContent, err := schemaFS.ReadFile("schema/codebase-validation.schema.json")
-		if err != nil {
-			fmt.Printf("❌ Failed to read embedded schema: %v\n", err)
-			os.Exit(1)
-		}
+		schemaContent := schema.JSON
 
 		if outputFile != "" {
 			// Save to file
 			if err := os.WriteFile(outputFile, schemaContent, 0644); err != nil {
 				fmt.Printf("❌ Failed to write schema file: %v\n", err)
-				os.Exit(1)
+				return &ExitError{Code: ExitInternalError}
 			}
 			fmt.Printf("✅ Schema saved to: %s\n", outputFile)
 			fmt.Printf("\n💡 You can now reference this schema in your YAML files:\n")
@@ -41,6 +34,7 @@ and validation while editing YAML configuration files.`,
 			// Display to stdout
 			fmt.Print(string(schemaContent))
 		}
+		return nil
 	},
 }
 
@@ -0,0 +1,13 @@
+// Package schema embeds the JSON schema for .codebase-validation.yml. The
+// file lives next to the commands because editors load it by its repository
+// URL, but the configuration loader validates against it too.
+package schema
+
+import (
+	_ "embed"
+)
+
+// JSON is the content of codebase-validation.schema.json.
+//
+//go:embed codebase-validation.schema.json
+var JSON []byte
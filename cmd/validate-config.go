@@ -2,32 +2,47 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
+	"strings"
 
+	"github.com/codebase-interface/cli/internal/config"
 	"github.com/spf13/cobra"
-	"github.com/xeipuuv/gojsonschema"
-	"gopkg.in/yaml.v3"
 )
 
 var validateConfigCmd = &cobra.Command{
 	Use:   "validate-config [path]",
-	Short: "Validate a .codebase-validation.yml configuration file",
-	Long: `Validate a .codebase-validation.yml configuration file against the JSON schema.
-This helps catch configuration errors and shows the expected format.`,
+	Short: "Validate a configuration file",
+	Long: `Validate a configuration file against the JSON schema.
+This helps catch configuration errors and shows the expected format.
+
+The path may name the file, in YAML, JSON or TOML, or a directory, which is
+searched the way validate finds its configuration.`,
 	Args: cobra.MaximumNArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		var configPath string
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target := "."
 		if len(args) > 0 {
-			// Check if argument is a file or directory
-			if filepath.Ext(args[0]) == ".yml" || filepath.Ext(args[0]) == ".yaml" {
-				configPath = args[0]
-			} else {
-				configPath = filepath.Join(args[0], ".codebase-validation.yml")
+			target = args[0]
+		}
+
+		// A directory is searched the way validate finds its configuration;
+		// anything else names the file itself
+		configPath := target
+		if info, err := os.Stat(target); err == nil && info.IsDir() {
+			found, err := config.Discover(target)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				return &ExitError{Code: ExitInternalError}
 			}
-		} else {
-			configPath = ".codebase-validation.yml"
+			if found == "" {
+				fmt.Printf("❌ No configuration file found for: %s\n", target)
+				fmt.Printf("   Looked for: %s\n", strings.Join(config.ConfigFileNames, ", "))
+				fmt.Println("\n💡 To create a configuration file, try:")
+				fmt.Println("   codebase-interface init-config")
+				return &ExitError{Code: ExitConfigError}
+			}
+			configPath = found
 		}
 
 		// Check if config file exists
@@ -35,54 +50,48 @@ This helps catch configuration errors and shows the expected format.`,
 			fmt.Printf("❌ Configuration file not found: %s\n", configPath)
 			fmt.Println("\n💡 To create a configuration file, try:")
 			fmt.Println("   codebase-interface init-config")
-			os.Exit(1)
+			return &ExitError{Code: ExitConfigError}
 		}
 
-		// Read and parse the YAML file
+		// Read the file
 		data, err := os.ReadFile(configPath)
 		if err != nil {
 			fmt.Printf("❌ Failed to read config file: %v\n", err)
-			os.Exit(1)
+			return &ExitError{Code: ExitInternalError}
 		}
 
-		// Validate YAML syntax
-		var config interface{}
-		if err := yaml.Unmarshal(data, &config); err != nil {
-			fmt.Printf("❌ Invalid YAML syntax: %v\n", err)
-			os.Exit(1)
-		}
+		// Validate the same way validate loads it: syntax, the JSON
+		// schema and known keys
+		if _, err := config.Parse(configPath, data); err != nil {
+			var configErr *config.ConfigError
+			if !errors.As(err, &configErr) {
+				fmt.Printf("❌ %v\n", err)
+				return &ExitError{Code: ExitConfigError}
+			}
 
-		// Convert to JSON for schema validation
-		jsonData, err := json.MarshalIndent(config, "", "  ")
-		if err != nil {
-			fmt.Printf("❌ Failed to convert to JSON: %v\n", err)
-			os.Exit(1)
+			fmt.Printf("❌ Configuration file has validation errors:\n\n")
+			for i, message := range configErr.Messages() {
+				fmt.Printf("%d. %s\n", i+1, message)
+			}
+			fmt.Printf("\n💡 Use 'codebase-interface schema -o schema.json' to get the full schema\n")
+			return &ExitError{Code: ExitConfigError}
 		}
 
-		// Load embedded schema
-		schemaContent, err := schemaFS.ReadFile("schema/codebase-validation.schema.json")
+		// Convert to JSON for the preview
+		root, err := config.ParseDocument(configPath, data)
 		if err != nil {
-			fmt.Printf("❌ Failed to load schema: %v\n", err)
-			os.Exit(1)
+			fmt.Printf("❌ %v\n", err)
+			return &ExitError{Code: ExitConfigError}
 		}
-
-		// Validate against JSON Schema
-		schemaLoader := gojsonschema.NewBytesLoader(schemaContent)
-		documentLoader := gojsonschema.NewBytesLoader(jsonData)
-
-		result, err := gojsonschema.Validate(schemaLoader, documentLoader)
-		if err != nil {
-			fmt.Printf("❌ Schema validation error: %v\n", err)
-			os.Exit(1)
+		var document interface{}
+		if err := root.Decode(&document); err != nil {
+			fmt.Printf("❌ Failed to read configuration: %v\n", err)
+			return &ExitError{Code: ExitInternalError}
 		}
-
-		if !result.Valid() {
-			fmt.Printf("❌ Configuration file has validation errors:\n\n")
-			for i, desc := range result.Errors() {
-				fmt.Printf("%d. %s\n", i+1, desc)
-			}
-			fmt.Printf("\n💡 Use 'codebase-interface schema -o schema.json' to get the full schema\n")
-			os.Exit(1)
+		jsonData, err := json.MarshalIndent(document, "", "  ")
+		if err != nil {
+			fmt.Printf("❌ Failed to convert to JSON: %v\n", err)
+			return &ExitError{Code: ExitInternalError}
 		}
 
 		fmt.Printf("✅ Configuration file is valid: %s\n", configPath)
@@ -90,6 +99,7 @@ This helps catch configuration errors and shows the expected format.`,
 		fmt.Printf("%s\n", string(jsonData))
 
 		fmt.Printf("\n💡 Schema documentation: https://cli.codebaseinterface.org/schema/\n")
+		return nil
 	},
 }
 
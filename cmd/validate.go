@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/codebase-interface/cli/internal/agents"
 	"github.com/codebase-interface/cli/internal/config"
@@ -14,6 +18,12 @@ var (
 	outputFormat string
 	targetPath   string
 	agentName    string
+	jobs         int
+	timeout      time.Duration
+	failOn       string
+	setValues    []string
+	configFile   string
+	verbose      bool
 )
 
 var validateCmd = &cobra.Command{
@@ -27,9 +37,29 @@ var validateCmd = &cobra.Command{
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
-	cfg, err := config.Load(targetPath)
+	if failOn != "" && !agents.ValidSeverity(failOn) {
+		return &ExitError{Code: ExitConfigError, Err: fmt.Errorf("invalid --fail-on value %q: must be one of %s", failOn, strings.Join(agents.Severities, ", "))}
+	}
+
+	cfg, _, usedFile, err := loadConfig(targetPath, configFile, setValues)
+	if err != nil {
+		return &ExitError{Code: ExitConfigError, Err: fmt.Errorf("failed to load configuration: %w", err)}
+	}
+
+	explicitFormat := cmd.Flags().Changed("output")
+	format := effectiveFormat(outputFormat, explicitFormat, cfg)
+	formatter, err := output.NewFormatter(format)
 	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return &ExitError{Code: ExitConfigError, Err: fmt.Errorf("invalid output format: %w", err)}
+	}
+
+	// Progress notes go to stderr so they never mix with the report.
+	if verbose || cfg.Validation.Output.Verbose {
+		if usedFile != "" {
+			fmt.Fprintf(os.Stderr, "Using configuration file: %s\n", usedFile)
+		} else {
+			fmt.Fprintln(os.Stderr, "No configuration file found, using the defaults")
+		}
 	}
 
 	agentRegistry := agents.NewRegistry()
@@ -37,53 +67,110 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	agentRegistry.Register("git-configuration", agents.NewGitConfigurationAgent())
 	agentRegistry.Register("development-standards", agents.NewDevelopmentStandardsAgent())
 
-	var results []agents.ValidationResult
-
+	var names []string
 	if agentName != "" {
-		agent, exists := agentRegistry.Get(agentName)
-		if !exists {
-			return fmt.Errorf("agent '%s' not found", agentName)
-		}
-
-		result, err := agent.Validate(targetPath, cfg)
-		if err != nil {
-			return fmt.Errorf("validation failed: %w", err)
+		if _, exists := agentRegistry.Get(agentName); !exists {
+			return &ExitError{Code: ExitConfigError, Err: fmt.Errorf("agent '%s' not found", agentName)}
 		}
-		results = append(results, result)
+		names = []string{agentName}
 	} else {
-		for name, agent := range agentRegistry.All() {
+		for _, name := range agentRegistry.Names() {
 			if cfg.IsAgentEnabled(name) {
-				result, err := agent.Validate(targetPath, cfg)
-				if err != nil {
-					return fmt.Errorf("validation failed for agent %s: %w", name, err)
-				}
-				results = append(results, result)
+				names = append(names, name)
 			}
 		}
 	}
 
-	formatter, err := output.NewFormatter(outputFormat)
-	if err != nil {
-		return fmt.Errorf("invalid output format: %w", err)
+	ctx := cmd.Context()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	if err := formatter.Format(results, os.Stdout); err != nil {
-		return fmt.Errorf("failed to format output: %w", err)
+	results, err := agentRegistry.Run(ctx, names, targetPath, cfg, jobs)
+	if errors.Is(err, context.Canceled) {
+		return &ExitError{Code: ExitInternalError, Err: fmt.Errorf("validation cancelled")}
+	}
+	if err != nil {
+		return &ExitError{Code: ExitInternalError, Err: err}
 	}
 
+	// Agents that could not run have no meaningful score; they are counted
+	// separately so they neither drag the score down nor let a run pass.
+	scores := make([]float64, 0, len(results))
+	errored := 0
 	for _, result := range results {
-		if result.Status == "fail" {
-			os.Exit(1)
+		if result.Status == agents.StatusError {
+			errored++
+			continue
 		}
+		scores = append(scores, result.Score)
+	}
+	summary := cfg.Validation.Scoring.Summarize(scores)
+	summary.Agents = len(results)
+	summary.Errors = errored
+
+	// With --fail-on, findings decide the outcome instead of the score.
+	if failOn != "" {
+		summary.FailOn = failOn
+		summary.Passed = agents.CountProblems(results, failOn) == 0
+		summary.Status = config.StatusPass
+		if !summary.Passed {
+			summary.Status = config.StatusFail
+		}
+	}
+	if errored > 0 {
+		summary.Passed = false
+	}
+
+	if err := formatter.Format(results, summary, os.Stdout); err != nil {
+		return &ExitError{Code: ExitInternalError, Err: fmt.Errorf("failed to format output: %w", err)}
+	}
+
+	if addGitHubAnnotations(format, explicitFormat, os.Getenv("GITHUB_ACTIONS") == "true") {
+		if err := (&output.GitHubFormatter{}).Format(results, summary, os.Stdout); err != nil {
+			return &ExitError{Code: ExitInternalError, Err: fmt.Errorf("failed to write GitHub annotations: %w", err)}
+		}
+	}
+
+	if summary.Errors > 0 {
+		return &ExitError{Code: ExitInternalError}
+	}
+	if !summary.Passed {
+		return &ExitError{Code: ExitValidationFailed}
 	}
 
 	return nil
 }
 
+// effectiveFormat returns the output format: the --output flag when given,
+// otherwise the configured format.
+func effectiveFormat(flag string, explicit bool, cfg *config.Config) string {
+	if explicit || cfg.Validation.Output.Format == "" {
+		return flag
+	}
+	return cfg.Validation.Output.Format
+}
+
+// addGitHubAnnotations reports whether to write GitHub annotations after the
+// report. On GitHub Actions they follow the table unless the format was
+// chosen explicitly; any other format would be corrupted by them, and the
+// github format already consists of them.
+func addGitHubAnnotations(format string, explicit, githubActions bool) bool {
+	return githubActions && !explicit && format == "table"
+}
+
 func init() {
 	rootCmd.AddCommand(validateCmd)
 
-	validateCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format (json, table)")
+	validateCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format (json, table, sarif, junit, markdown, html, github)")
 	validateCmd.Flags().StringVarP(&targetPath, "path", "p", ".", "Path to validate")
 	validateCmd.Flags().StringVarP(&agentName, "agent", "a", "", "Run specific agent (essential-files, git-configuration, development-standards)")
+	validateCmd.Flags().IntVarP(&jobs, "jobs", "j", 0, "Number of agents to run in parallel (0 uses one per CPU)")
+	validateCmd.Flags().DurationVar(&timeout, "timeout", 0, "Time limit for the whole validation run, e.g. 2m (0 means no limit)")
+	validateCmd.Flags().StringVar(&failOn, "fail-on", "", "Fail on findings of this severity or worse (critical, warning, info) instead of the score")
+	validateCmd.Flags().StringVarP(&configFile, "config", "c", "", "Configuration file to use instead of the one discovered from --path")
+	validateCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Report which configuration file is used")
+	validateCmd.Flags().StringArrayVar(&setValues, "set", nil, "Override a setting, e.g. validation.output.verbose=true (repeatable)")
 }
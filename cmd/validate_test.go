@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+func TestEffectiveFormat(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Validation.Output.Format = "json"
+
+	if format := effectiveFormat("table", false, cfg); format != "json" {
+		t.Errorf("Expected the configured format without --output, got %s", format)
+	}
+	if format := effectiveFormat("sarif", true, cfg); format != "sarif" {
+		t.Errorf("Expected --output to win over the configuration, got %s", format)
+	}
+}
+
+func TestAddGitHubAnnotations(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		explicit      bool
+		githubActions bool
+		expected      bool
+	}{
+		{name: "table on GitHub Actions", format: "table", githubActions: true, expected: true},
+		{name: "outside GitHub Actions", format: "table", expected: false},
+		{name: "explicit table", format: "table", explicit: true, githubActions: true, expected: false},
+		{name: "configured json", format: "json", githubActions: true, expected: false},
+		{name: "configured sarif", format: "sarif", githubActions: true, expected: false},
+		{name: "configured junit", format: "junit", githubActions: true, expected: false},
+		{name: "configured markdown", format: "markdown", githubActions: true, expected: false},
+		{name: "configured html", format: "html", githubActions: true, expected: false},
+		{name: "configured github", format: "github", githubActions: true, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addGitHubAnnotations(tt.format, tt.explicit, tt.githubActions); got != tt.expected {
+				t.Errorf("addGitHubAnnotations(%q, %v, %v) = %v, expected %v", tt.format, tt.explicit, tt.githubActions, got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,11 +1,12 @@
 package agents
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/codebase-interface/cli/internal/config"
@@ -13,24 +14,60 @@ import (
 
 type ValidationResult struct {
 	Agent    string    `json:"agent"`
-	Status   string    `json:"status"` // pass, fail, warning
+	Status   string    `json:"status"` // pass, fail, warning, error
 	Score    float64   `json:"score"`  // 0.0-1.0
+	Error    string    `json:"error,omitempty"`
 	Findings []Finding `json:"findings"`
 }
 
+// StatusError marks a result whose agent could not run to completion. Its
+// Error field says why, and its score takes no part in the overall score.
+const StatusError = "error"
+
+// Finding is the outcome of one check. Check identifies the check, such as
+// "readme" or "gitignore-patterns:go", and stays the same from run to run;
+// Message may not, as it includes counts and commit hashes.
 type Finding struct {
+	Check    string `json:"check"`
 	Type     string `json:"type"` // missing, present, invalid
 	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
 	Message  string `json:"message"`
 	Severity string `json:"severity"` // critical, warning, info
 }
 
+// Pseudo-paths used as Finding.File by checks that concern the repository
+// rather than a file in it.
+const (
+	FileGitHistory = "git-history"
+	FileGitBranch  = "git-branch"
+)
+
+// NamesFile reports whether File is an existing file in the project, rather
+// than a missing file, a directory, a glob pattern or a pseudo-path.
+func (f Finding) NamesFile() bool {
+	if f.File == "" || f.Type == "missing" || strings.HasSuffix(f.File, "/") {
+		return false
+	}
+	return f.File != FileGitHistory && f.File != FileGitBranch
+}
+
 type Agent interface {
 	Validate(targetPath string, cfg *config.Config) (ValidationResult, error)
 }
 
+// ContextAgent is an Agent that can be cancelled. Agents that shell out or
+// walk the file tree implement it so a timeout or Ctrl-C stops their work.
+type ContextAgent interface {
+	Agent
+	ValidateContext(ctx context.Context, targetPath string, cfg *config.Config) (ValidationResult, error)
+}
+
+// Registry holds the agents by name and remembers the order in which they
+// were registered, which is the order results are reported in.
 type Registry struct {
 	agents map[string]Agent
+	order  []string
 }
 
 func NewRegistry() *Registry {
@@ -40,6 +77,9 @@ func NewRegistry() *Registry {
 }
 
 func (r *Registry) Register(name string, agent Agent) {
+	if _, exists := r.agents[name]; !exists {
+		r.order = append(r.order, name)
+	}
 	r.agents[name] = agent
 }
 
@@ -52,6 +92,11 @@ func (r *Registry) All() map[string]Agent {
 	return r.agents
 }
 
+// Names returns the registered agent names in registration order.
+func (r *Registry) Names() []string {
+	return append([]string(nil), r.order...)
+}
+
 type EssentialFilesAgent struct{}
 
 func NewEssentialFilesAgent() *EssentialFilesAgent {
@@ -59,6 +104,11 @@ func NewEssentialFilesAgent() *EssentialFilesAgent {
 }
 
 func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (ValidationResult, error) {
+	return a.ValidateContext(context.Background(), targetPath, cfg)
+}
+
+// ValidateContext runs the checks, stopping file tree walks when ctx is done.
+func (a *EssentialFilesAgent) ValidateContext(ctx context.Context, targetPath string, cfg *config.Config) (ValidationResult, error) {
 	result := ValidationResult{
 		Agent:    "essential-files",
 		Status:   "pass",
@@ -70,28 +120,23 @@ func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (V
 	totalChecks := 0
 	passedChecks := 0
 
+	readmePath := findReadme(targetPath)
+
 	if agentCfg.RequireReadme {
 		totalChecks++
-		readmePaths := []string{"README.md", "README.rst", "readme.md", "readme.rst"}
-		found := false
-
-		for _, readmePath := range readmePaths {
-			if _, err := os.Stat(filepath.Join(targetPath, readmePath)); err == nil {
-				found = true
-				result.Findings = append(result.Findings, Finding{
-					Type:     "present",
-					File:     readmePath,
-					Message:  fmt.Sprintf("%s present", readmePath),
-					Severity: "info",
-				})
-				break
-			}
-		}
 
-		if found {
+		if readmePath != "" {
 			passedChecks++
+			result.Findings = append(result.Findings, Finding{
+				Check:    "readme",
+				Type:     "present",
+				File:     readmePath,
+				Message:  fmt.Sprintf("%s present", readmePath),
+				Severity: "info",
+			})
 		} else {
 			result.Findings = append(result.Findings, Finding{
+				Check:    "readme",
 				Type:     "missing",
 				File:     "README.md",
 				Message:  "README.md or README.rst missing",
@@ -100,6 +145,16 @@ func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (V
 		}
 	}
 
+	if readmePath != "" {
+		findings, total, passed, err := a.checkReadmeQuality(targetPath, readmePath, agentCfg.ReadmeQuality)
+		if err != nil {
+			return result, err
+		}
+		result.Findings = append(result.Findings, findings...)
+		totalChecks += total
+		passedChecks += passed
+	}
+
 	if agentCfg.RequireContributing {
 		totalChecks++
 		contributingPath := filepath.Join(targetPath, "CONTRIBUTING.md")
@@ -107,6 +162,7 @@ func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (V
 		if _, err := os.Stat(contributingPath); err == nil {
 			passedChecks++
 			result.Findings = append(result.Findings, Finding{
+				Check:    "contributing",
 				Type:     "present",
 				File:     "CONTRIBUTING.md",
 				Message:  "CONTRIBUTING.md present",
@@ -114,6 +170,7 @@ func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (V
 			})
 		} else {
 			result.Findings = append(result.Findings, Finding{
+				Check:    "contributing",
 				Type:     "missing",
 				File:     "CONTRIBUTING.md",
 				Message:  "CONTRIBUTING.md missing",
@@ -122,6 +179,54 @@ func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (V
 		}
 	}
 
+	docsFindings, docsTotal, docsPassed, err := a.checkDocs(ctx, targetPath, agentCfg)
+	if err != nil {
+		return result, err
+	}
+	result.Findings = append(result.Findings, docsFindings...)
+	totalChecks += docsTotal
+	passedChecks += docsPassed
+
+	for _, customFile := range agentCfg.CustomFiles {
+		if customFile.Required {
+			totalChecks++
+		}
+
+		matches, err := globFiles(ctx, targetPath, customFile.Pattern)
+		if err != nil {
+			return result, fmt.Errorf("failed to match custom file pattern %q: %w", customFile.Pattern, err)
+		}
+
+		if len(matches) > 0 {
+			if customFile.Required {
+				passedChecks++
+			}
+			result.Findings = append(result.Findings, Finding{
+				Check:    "custom-file:" + customFile.Pattern,
+				Type:     "present",
+				File:     matches[0],
+				Message:  describeCustomFile(customFile, fmt.Sprintf("%s present", listPathsBriefly(matches))),
+				Severity: "info",
+			})
+		} else if customFile.Required {
+			result.Findings = append(result.Findings, Finding{
+				Check:    "custom-file:" + customFile.Pattern,
+				Type:     "missing",
+				File:     customFile.Pattern,
+				Message:  describeCustomFile(customFile, fmt.Sprintf("no file matching %s found", customFile.Pattern)),
+				Severity: "critical",
+			})
+		} else {
+			result.Findings = append(result.Findings, Finding{
+				Check:    "custom-file:" + customFile.Pattern,
+				Type:     "missing",
+				File:     customFile.Pattern,
+				Message:  describeCustomFile(customFile, fmt.Sprintf("no file matching %s found (optional)", customFile.Pattern)),
+				Severity: "warning",
+			})
+		}
+	}
+
 	if totalChecks > 0 {
 		result.Score = float64(passedChecks) / float64(totalChecks)
 	}
@@ -133,6 +238,237 @@ func (a *EssentialFilesAgent) Validate(targetPath string, cfg *config.Config) (V
 	return result, nil
 }
 
+func (a *EssentialFilesAgent) checkReadmeQuality(targetPath, readmePath string, qualityCfg config.ReadmeQualityConfig) ([]Finding, int, int, error) {
+	var findings []Finding
+	totalChecks := 0
+	passedChecks := 0
+
+	if qualityCfg == (config.ReadmeQualityConfig{}) {
+		return findings, 0, 0, nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(targetPath, readmePath))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to read %s: %w", readmePath, err)
+	}
+
+	doc := parseReadme(string(content), strings.EqualFold(filepath.Ext(readmePath), ".rst"))
+
+	check := func(key string, ok bool, severity, passMessage, failMessage string) {
+		totalChecks++
+		if ok {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    key,
+				Type:     "present",
+				File:     readmePath,
+				Message:  passMessage,
+				Severity: "info",
+			})
+		} else {
+			findings = append(findings, Finding{
+				Check:    key,
+				Type:     "invalid",
+				File:     readmePath,
+				Message:  failMessage,
+				Severity: severity,
+			})
+		}
+	}
+
+	if qualityCfg.MinLines > 0 {
+		check("readme-min-lines", doc.NonBlankLines >= qualityCfg.MinLines, "critical",
+			fmt.Sprintf("%s has %d non-blank lines", readmePath, doc.NonBlankLines),
+			fmt.Sprintf("%s has %d non-blank lines (minimum %d)", readmePath, doc.NonBlankLines, qualityCfg.MinLines))
+	}
+
+	if qualityCfg.RequireDescription {
+		check("readme-description", doc.Description != "", "critical",
+			fmt.Sprintf("%s has a project description", readmePath),
+			fmt.Sprintf("%s is missing a project description paragraph", readmePath))
+	}
+
+	var sections []*regexp.Regexp
+	if qualityCfg.RequireInstallation {
+		sections = append(sections, installationHeadingPattern)
+	}
+	if qualityCfg.RequireUsage {
+		sections = append(sections, usageHeadingPattern)
+	}
+	found := doc.Sections(sections...)
+
+	if qualityCfg.RequireInstallation {
+		check("readme-installation", found[0], "critical",
+			fmt.Sprintf("%s has an installation section", readmePath),
+			fmt.Sprintf("%s is missing an installation section", readmePath))
+	}
+
+	if qualityCfg.RequireUsage {
+		check("readme-usage", found[len(found)-1], "critical",
+			fmt.Sprintf("%s has a usage section", readmePath),
+			fmt.Sprintf("%s is missing a usage section", readmePath))
+	}
+
+	if qualityCfg.CheckBadges {
+		check("readme-badges", len(doc.Badges) > 0, "warning",
+			fmt.Sprintf("%s has %d status badge(s)", readmePath, len(doc.Badges)),
+			fmt.Sprintf("%s has no status badges", readmePath))
+	}
+
+	return findings, totalChecks, passedChecks, nil
+}
+
+func (a *EssentialFilesAgent) checkDocs(ctx context.Context, targetPath string, agentCfg config.EssentialFilesConfig) ([]Finding, int, int, error) {
+	var findings []Finding
+	totalChecks := 0
+	passedChecks := 0
+
+	docsDir := agentCfg.DocsDirectory
+	if docsDir == "" {
+		docsDir = "docs"
+	}
+	docsDir = filepath.ToSlash(filepath.Clean(docsDir))
+	docsPath := filepath.Join(targetPath, docsDir)
+
+	info, err := os.Stat(docsPath)
+	docsExists := err == nil && info.IsDir()
+
+	if agentCfg.RequireDocsDirectory {
+		totalChecks++
+		if docsExists {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    "docs-directory",
+				Type:     "present",
+				File:     docsDir + "/",
+				Message:  fmt.Sprintf("%s/ directory present", docsDir),
+				Severity: "info",
+			})
+		} else {
+			findings = append(findings, Finding{
+				Check:    "docs-directory",
+				Type:     "missing",
+				File:     docsDir + "/",
+				Message:  fmt.Sprintf("%s/ directory missing", docsDir),
+				Severity: "critical",
+			})
+		}
+	}
+
+	requirements := agentCfg.DocsRequirements
+	if requirements == (config.DocsRequirementsConfig{}) {
+		return findings, totalChecks, passedChecks, nil
+	}
+
+	// Without the directory the requirements are checked against an empty
+	// inventory, so each one is reported as missing.
+	var inventory docsInventory
+	if docsExists {
+		if inventory, err = inventoryDocs(ctx, docsPath, targetPath); err != nil {
+			return nil, 0, 0, fmt.Errorf("failed to scan %s: %w", docsDir, err)
+		}
+	}
+
+	if requirements.MinDocFiles > 0 {
+		totalChecks++
+		count := len(inventory.DocFiles)
+		if count >= requirements.MinDocFiles {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    "docs-min-files",
+				Type:     "present",
+				File:     docsDir + "/",
+				Message:  fmt.Sprintf("%s/ contains %d documentation files", docsDir, count),
+				Severity: "info",
+			})
+		} else {
+			findings = append(findings, Finding{
+				Check:    "docs-min-files",
+				Type:     "invalid",
+				File:     docsDir + "/",
+				Message:  fmt.Sprintf("%s/ contains %d documentation files (minimum %d)", docsDir, count, requirements.MinDocFiles),
+				Severity: "critical",
+			})
+		}
+	}
+
+	if requirements.RequireUsageGuide {
+		totalChecks++
+		if inventory.UsageGuide != "" {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    "docs-usage-guide",
+				Type:     "present",
+				File:     inventory.UsageGuide,
+				Message:  fmt.Sprintf("Usage guide present: %s", inventory.UsageGuide),
+				Severity: "info",
+			})
+		} else {
+			findings = append(findings, Finding{
+				Check:    "docs-usage-guide",
+				Type:     "missing",
+				File:     docsDir + "/usage.md",
+				Message:  fmt.Sprintf("Usage guide missing (expected %s/usage.md)", docsDir),
+				Severity: "critical",
+			})
+		}
+	}
+
+	if requirements.RequireExamples {
+		totalChecks++
+		examplesDir := inventory.ExamplesDir
+		if examplesDir == "" {
+			for _, name := range []string{"examples", "example"} {
+				if path := filepath.Join(targetPath, name); hasFiles(path) {
+					examplesDir = name
+					break
+				}
+			}
+		}
+
+		if examplesDir != "" {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    "docs-examples",
+				Type:     "present",
+				File:     examplesDir + "/",
+				Message:  fmt.Sprintf("Examples directory present: %s/", examplesDir),
+				Severity: "info",
+			})
+		} else {
+			findings = append(findings, Finding{
+				Check:    "docs-examples",
+				Type:     "missing",
+				File:     docsDir + "/examples/",
+				Message:  fmt.Sprintf("Examples directory missing (expected %s/examples/)", docsDir),
+				Severity: "critical",
+			})
+		}
+	}
+
+	return findings, totalChecks, passedChecks, nil
+}
+
+func describeCustomFile(customFile config.CustomFileConfig, message string) string {
+	if customFile.Description == "" {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", customFile.Description, message)
+}
+
+// maxListedPaths is how many paths a finding message names before
+// summarizing the rest.
+const maxListedPaths = 5
+
+// listPathsBriefly joins paths for a finding message, naming at most
+// maxListedPaths of them and counting the others.
+func listPathsBriefly(paths []string) string {
+	if len(paths) <= maxListedPaths {
+		return strings.Join(paths, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(paths[:maxListedPaths], ", "), len(paths)-maxListedPaths)
+}
+
 type GitConfigurationAgent struct{}
 
 func NewGitConfigurationAgent() *GitConfigurationAgent {
@@ -140,6 +476,11 @@ func NewGitConfigurationAgent() *GitConfigurationAgent {
 }
 
 func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config) (ValidationResult, error) {
+	return a.ValidateContext(context.Background(), targetPath, cfg)
+}
+
+// ValidateContext runs the checks, killing git processes when ctx is done.
+func (a *GitConfigurationAgent) ValidateContext(ctx context.Context, targetPath string, cfg *config.Config) (ValidationResult, error) {
 	result := ValidationResult{
 		Agent:    "git-configuration",
 		Status:   "pass",
@@ -158,6 +499,7 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 		if _, err := os.Stat(gitignorePath); err == nil {
 			passedChecks++
 			result.Findings = append(result.Findings, Finding{
+				Check:    "gitignore",
 				Type:     "present",
 				File:     ".gitignore",
 				Message:  ".gitignore present",
@@ -165,6 +507,7 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 			})
 		} else {
 			result.Findings = append(result.Findings, Finding{
+				Check:    "gitignore",
 				Type:     "missing",
 				File:     ".gitignore",
 				Message:  ".gitignore missing",
@@ -173,6 +516,16 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 		}
 	}
 
+	if agentCfg.ValidationRules.GitignoreValidation {
+		findings, total, passed, err := a.checkGitignoreContent(ctx, targetPath, agentCfg.GitignoreValidation)
+		if err != nil {
+			return result, err
+		}
+		result.Findings = append(result.Findings, findings...)
+		totalChecks += total
+		passedChecks += passed
+	}
+
 	if agentCfg.RequireGitattributes {
 		totalChecks++
 		gitattributesPath := filepath.Join(targetPath, ".gitattributes")
@@ -180,6 +533,7 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 		if _, err := os.Stat(gitattributesPath); err == nil {
 			passedChecks++
 			result.Findings = append(result.Findings, Finding{
+				Check:    "gitattributes",
 				Type:     "present",
 				File:     ".gitattributes",
 				Message:  ".gitattributes present",
@@ -187,6 +541,7 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 			})
 		} else {
 			result.Findings = append(result.Findings, Finding{
+				Check:    "gitattributes",
 				Type:     "missing",
 				File:     ".gitattributes",
 				Message:  ".gitattributes missing (optional)",
@@ -195,6 +550,16 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 		}
 	}
 
+	if agentCfg.ValidationRules.GitattributesValidation {
+		findings, total, passed, err := a.checkGitattributesContent(ctx, targetPath, agentCfg.GitattributesValidation)
+		if err != nil {
+			return result, err
+		}
+		result.Findings = append(result.Findings, findings...)
+		totalChecks += total
+		passedChecks += passed
+	}
+
 	if agentCfg.RequireEditorconfig {
 		totalChecks++
 		editorconfigPath := filepath.Join(targetPath, ".editorconfig")
@@ -202,6 +567,7 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 		if _, err := os.Stat(editorconfigPath); err == nil {
 			passedChecks++
 			result.Findings = append(result.Findings, Finding{
+				Check:    "editorconfig",
 				Type:     "present",
 				File:     ".editorconfig",
 				Message:  ".editorconfig present",
@@ -209,6 +575,7 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 			})
 		} else {
 			result.Findings = append(result.Findings, Finding{
+				Check:    "editorconfig",
 				Type:     "missing",
 				File:     ".editorconfig",
 				Message:  ".editorconfig missing",
@@ -217,6 +584,16 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 		}
 	}
 
+	if agentCfg.ValidationRules.EditorconfigValidation {
+		findings, total, passed, err := a.checkEditorconfigContent(ctx, targetPath, agentCfg.EditorconfigValidation)
+		if err != nil {
+			return result, err
+		}
+		result.Findings = append(result.Findings, findings...)
+		totalChecks += total
+		passedChecks += passed
+	}
+
 	if totalChecks > 0 {
 		result.Score = float64(passedChecks) / float64(totalChecks)
 	}
@@ -228,6 +605,304 @@ func (a *GitConfigurationAgent) Validate(targetPath string, cfg *config.Config)
 	return result, nil
 }
 
+func (a *GitConfigurationAgent) checkGitignoreContent(ctx context.Context, targetPath string, ignoreCfg config.GitignoreValidationConfig) ([]Finding, int, int, error) {
+	var findings []Finding
+	totalChecks := 0
+	passedChecks := 0
+
+	rules, err := parseGitignore(filepath.Join(targetPath, ".gitignore"))
+	if os.IsNotExist(err) {
+		return findings, 0, 0, nil
+	}
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+
+	if ignoreCfg.CheckLanguageSpecific {
+		var languages []string
+		if ignoreCfg.DetectProjectType {
+			// Keys that are not a detectable language, such as general,
+			// apply to every project.
+			languages = detectLanguages(targetPath)
+			for key := range ignoreCfg.RequiredPatterns {
+				if _, detectable := projectMarkers[key]; !detectable {
+					languages = append(languages, key)
+				}
+			}
+			sort.Strings(languages)
+		} else {
+			for language := range ignoreCfg.RequiredPatterns {
+				languages = append(languages, language)
+			}
+			sort.Strings(languages)
+		}
+
+		for _, language := range languages {
+			required := ignoreCfg.RequiredPatterns[language]
+			if len(required) == 0 {
+				required = defaultGitignorePatterns[language]
+			}
+			if len(required) == 0 {
+				continue
+			}
+
+			totalChecks++
+			if missing := missingIgnorePatterns(rules, required); len(missing) == 0 {
+				passedChecks++
+				findings = append(findings, Finding{
+					Check:    "gitignore-patterns:" + language,
+					Type:     "present",
+					File:     ".gitignore",
+					Message:  fmt.Sprintf(".gitignore covers %s patterns", language),
+					Severity: "info",
+				})
+			} else {
+				findings = append(findings, Finding{
+					Check:    "gitignore-patterns:" + language,
+					Type:     "invalid",
+					File:     ".gitignore",
+					Message:  fmt.Sprintf(".gitignore is missing %s patterns: %s", language, strings.Join(missing, ", ")),
+					Severity: "critical",
+				})
+			}
+		}
+	}
+
+	ignoredFiles, isRepo, err := trackedIgnoredFiles(ctx, targetPath)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if isRepo {
+		totalChecks++
+		if len(ignoredFiles) == 0 {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    "gitignore-tracked-files",
+				Type:     "present",
+				File:     ".gitignore",
+				Message:  "No tracked files match .gitignore rules",
+				Severity: "info",
+			})
+		}
+		for _, file := range ignoredFiles {
+			findings = append(findings, Finding{
+				Check:    "gitignore-tracked-files",
+				Type:     "invalid",
+				File:     file,
+				Message:  fmt.Sprintf("Tracked file %s matches .gitignore rules", file),
+				Severity: "warning",
+			})
+		}
+	}
+
+	return findings, totalChecks, passedChecks, nil
+}
+
+func (a *GitConfigurationAgent) checkGitattributesContent(ctx context.Context, targetPath string, attributesCfg config.GitattributesValidationConfig) ([]Finding, int, int, error) {
+	var findings []Finding
+	totalChecks := 0
+	passedChecks := 0
+
+	rules, err := parseGitattributes(filepath.Join(targetPath, ".gitattributes"))
+	if os.IsNotExist(err) {
+		return findings, 0, 0, nil
+	}
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to read .gitattributes: %w", err)
+	}
+
+	totalChecks++
+	if normalizesLineEndings(rules) {
+		passedChecks++
+		findings = append(findings, Finding{
+			Check:    "gitattributes-line-endings",
+			Type:     "present",
+			File:     ".gitattributes",
+			Message:  ".gitattributes normalizes line endings",
+			Severity: "info",
+		})
+	} else {
+		findings = append(findings, Finding{
+			Check:    "gitattributes-line-endings",
+			Type:     "invalid",
+			File:     ".gitattributes",
+			Message:  ".gitattributes declares neither '* text=auto' nor eol rules; line endings are not normalized",
+			Severity: "critical",
+		})
+	}
+
+	if len(attributesCfg.BinaryExtensions) > 0 {
+		files, err := projectFiles(ctx, targetPath)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		binaryExtensions := normalizeExtensions(attributesCfg.BinaryExtensions)
+		var extensions []string
+		unmarked := map[string][]string{}
+		found := false
+		for _, file := range files {
+			extension := fileExtension(file)
+			if !containsString(binaryExtensions, extension) {
+				continue
+			}
+			found = true
+			if handlesBinary(attributesFor(rules, file)) {
+				continue
+			}
+			if _, seen := unmarked[extension]; !seen {
+				extensions = append(extensions, extension)
+			}
+			unmarked[extension] = append(unmarked[extension], file)
+		}
+
+		if found {
+			totalChecks++
+			if len(extensions) == 0 {
+				passedChecks++
+				findings = append(findings, Finding{
+					Check:    "gitattributes-binary-files",
+					Type:     "present",
+					File:     ".gitattributes",
+					Message:  "Binary files are marked binary or stored with LFS",
+					Severity: "info",
+				})
+			}
+		}
+		sort.Strings(extensions)
+		for _, extension := range extensions {
+			matched := unmarked[extension]
+			findings = append(findings, Finding{
+				Check:    "gitattributes-binary-files",
+				Type:     "invalid",
+				File:     ".gitattributes",
+				Message:  fmt.Sprintf(".gitattributes does not mark *.%s as binary or filter=lfs (%d files, e.g. %s)", extension, len(matched), matched[0]),
+				Severity: "critical",
+			})
+		}
+	}
+
+	infos, isRepo, err := readEOLInfo(ctx, targetPath)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if isRepo {
+		totalChecks++
+		var contradictions []Finding
+		for _, info := range infos {
+			if message := info.Contradiction(); message != "" {
+				contradictions = append(contradictions, Finding{
+					Check:    "gitattributes-eol",
+					Type:     "invalid",
+					File:     info.Path,
+					Message:  message,
+					Severity: "warning",
+				})
+			}
+		}
+		if len(contradictions) == 0 {
+			passedChecks++
+			findings = append(findings, Finding{
+				Check:    "gitattributes-eol",
+				Type:     "present",
+				File:     ".gitattributes",
+				Message:  "Tracked file line endings match .gitattributes",
+				Severity: "info",
+			})
+		}
+		findings = append(findings, contradictions...)
+	}
+
+	return findings, totalChecks, passedChecks, nil
+}
+
+func (a *GitConfigurationAgent) checkEditorconfigContent(ctx context.Context, targetPath string, editorCfg config.EditorconfigValidationConfig) ([]Finding, int, int, error) {
+	var findings []Finding
+	totalChecks := 0
+	passedChecks := 0
+
+	content, err := os.ReadFile(filepath.Join(targetPath, ".editorconfig"))
+	if os.IsNotExist(err) {
+		return findings, 0, 0, nil
+	}
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to read .editorconfig: %w", err)
+	}
+
+	file, issues := parseEditorconfig(string(content))
+
+	totalChecks++
+	for _, issue := range issues {
+		findings = append(findings, Finding{
+			Check:    "editorconfig-syntax",
+			Type:     "invalid",
+			File:     ".editorconfig",
+			Line:     issue.Line,
+			Message:  fmt.Sprintf(".editorconfig:%d: %s", issue.Line, issue.Message),
+			Severity: SeverityCritical,
+		})
+	}
+	if len(issues) == 0 {
+		passedChecks++
+		findings = append(findings, Finding{
+			Check:    "editorconfig-syntax",
+			Type:     "present",
+			File:     ".editorconfig",
+			Message:  ".editorconfig is valid",
+			Severity: "info",
+		})
+	}
+
+	if !editorCfg.CheckFileCompliance {
+		return findings, totalChecks, passedChecks, nil
+	}
+
+	violations, err := editorconfigViolations(ctx, targetPath, file)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	totalChecks++
+	if len(violations) == 0 {
+		passedChecks++
+		findings = append(findings, Finding{
+			Check:    "editorconfig-compliance",
+			Type:     "present",
+			File:     ".editorconfig",
+			Message:  "All files comply with .editorconfig",
+			Severity: "info",
+		})
+	}
+
+	for i, violation := range violations {
+		if i == maxEditorconfigViolations {
+			findings = append(findings, Finding{
+				Check:    "editorconfig-compliance",
+				Type:     "invalid",
+				File:     ".editorconfig",
+				Message:  fmt.Sprintf("%d more .editorconfig violations not shown", len(violations)-i),
+				Severity: "warning",
+			})
+			break
+		}
+
+		message := fmt.Sprintf("%s:%d: %s (%s)", violation.File, violation.Line, violation.Message, violation.Property)
+		if violation.Count > 1 {
+			message += fmt.Sprintf(", %d lines", violation.Count)
+		}
+		findings = append(findings, Finding{
+			Check:    "editorconfig-compliance",
+			Type:     "invalid",
+			File:     violation.File,
+			Line:     violation.Line,
+			Message:  message,
+			Severity: "warning",
+		})
+	}
+
+	return findings, totalChecks, passedChecks, nil
+}
+
 type DevelopmentStandardsAgent struct{}
 
 func NewDevelopmentStandardsAgent() *DevelopmentStandardsAgent {
@@ -235,6 +910,11 @@ func NewDevelopmentStandardsAgent() *DevelopmentStandardsAgent {
 }
 
 func (a *DevelopmentStandardsAgent) Validate(targetPath string, cfg *config.Config) (ValidationResult, error) {
+	return a.ValidateContext(context.Background(), targetPath, cfg)
+}
+
+// ValidateContext runs the checks, killing git processes when ctx is done.
+func (a *DevelopmentStandardsAgent) ValidateContext(ctx context.Context, targetPath string, cfg *config.Config) (ValidationResult, error) {
 	result := ValidationResult{
 		Agent:    "development-standards",
 		Status:   "pass",
@@ -246,57 +926,106 @@ func (a *DevelopmentStandardsAgent) Validate(targetPath string, cfg *config.Conf
 	totalChecks := 0
 	passedChecks := 0
 
-	if agentCfg.CheckCommitHistory && agentCfg.RequireConventionalCommits {
+	checkCommits := agentCfg.CheckCommitHistory && agentCfg.RequireConventionalCommits
+	if !checkCommits && !agentCfg.BranchValidation {
+		return result, nil
+	}
+
+	// A project that is not a repository fails the checks; only git itself
+	// failing to run is an agent error.
+	inRepo, err := checkWorkTree(ctx, targetPath)
+	if err != nil {
+		return result, fmt.Errorf("failed to check for a git repository: %w", err)
+	}
+
+	if checkCommits && !inRepo {
+		totalChecks++
+		result.Findings = append(result.Findings, Finding{
+			Check:    "conventional-commits",
+			Type:     "missing",
+			File:     FileGitHistory,
+			Message:  "Not a git repository, so there is no commit history to check",
+			Severity: "warning",
+		})
+	} else if checkCommits {
 		totalChecks++
 
-		if hasConventionalCommits, err := a.checkConventionalCommits(targetPath, agentCfg.CommitHistoryDepth); err != nil {
-			result.Findings = append(result.Findings, Finding{
-				Type:     "invalid",
-				File:     "git-history",
-				Message:  fmt.Sprintf("Failed to check commit history: %v", err),
-				Severity: "warning",
-			})
-		} else if hasConventionalCommits {
+		report, err := a.checkConventionalCommits(ctx, targetPath, agentCfg)
+		if err != nil {
+			return result, fmt.Errorf("failed to check commit history: %w", err)
+		}
+		result.Findings = append(result.Findings, report.Findings...)
+
+		summary := fmt.Sprintf("%d of %d recent commits follow conventional commit rules (threshold %.0f%%)",
+			report.Compliant, report.Analysed, agentCfg.ValidationThreshold*100)
+		if excluded := report.ExcludedSummary(); excluded != "" {
+			summary += fmt.Sprintf("; excluded %s", excluded)
+		}
+
+		if report.Passed(agentCfg.ValidationThreshold) {
 			passedChecks++
 			result.Findings = append(result.Findings, Finding{
+				Check:    "conventional-commits",
 				Type:     "present",
-				File:     "git-history",
-				Message:  "Recent commits follow conventional format",
+				File:     FileGitHistory,
+				Message:  summary,
 				Severity: "info",
 			})
 		} else {
 			result.Findings = append(result.Findings, Finding{
+				Check:    "conventional-commits",
 				Type:     "invalid",
-				File:     "git-history",
-				Message:  "Recent commits don't follow conventional format",
+				File:     FileGitHistory,
+				Message:  summary,
 				Severity: "critical",
 			})
 		}
 	}
 
-	totalChecks++
-	if branchValid, branchName, err := a.checkBranchNaming(targetPath); err != nil {
-		result.Findings = append(result.Findings, Finding{
-			Type:     "invalid",
-			File:     "git-branch",
-			Message:  fmt.Sprintf("Failed to check branch naming: %v", err),
-			Severity: "warning",
-		})
-	} else if branchValid {
-		passedChecks++
-		result.Findings = append(result.Findings, Finding{
-			Type:     "present",
-			File:     "git-branch",
-			Message:  fmt.Sprintf("Branch naming follows conventions: %s", branchName),
-			Severity: "info",
-		})
-	} else {
+	if agentCfg.BranchValidation && !inRepo {
+		totalChecks++
 		result.Findings = append(result.Findings, Finding{
-			Type:     "invalid",
-			File:     "git-branch",
-			Message:  fmt.Sprintf("Branch name doesn't follow conventions: %s", branchName),
+			Check:    "branch-naming",
+			Type:     "missing",
+			File:     FileGitBranch,
+			Message:  "Not a git repository, so there is no branch to check",
 			Severity: "warning",
 		})
+	} else if agentCfg.BranchValidation {
+		totalChecks++
+
+		match, err := a.checkBranchNaming(ctx, targetPath, agentCfg.BranchNaming)
+		if err != nil {
+			return result, fmt.Errorf("failed to check branch naming: %w", err)
+		}
+
+		if match.Protected {
+			passedChecks++
+			result.Findings = append(result.Findings, Finding{
+				Check:    "branch-naming",
+				Type:     "present",
+				File:     FileGitBranch,
+				Message:  fmt.Sprintf("Branch %s is a protected branch", match.Branch),
+				Severity: "info",
+			})
+		} else if match.Valid {
+			passedChecks++
+			result.Findings = append(result.Findings, Finding{
+				Check:    "branch-naming",
+				Type:     "present",
+				File:     FileGitBranch,
+				Message:  fmt.Sprintf("Branch naming follows conventions: %s (matches %s)", match.Branch, match.Pattern),
+				Severity: "info",
+			})
+		} else {
+			result.Findings = append(result.Findings, Finding{
+				Check:    "branch-naming",
+				Type:     "invalid",
+				File:     FileGitBranch,
+				Message:  fmt.Sprintf("Branch name doesn't follow conventions: %s (%s)", match.Branch, match.Reason),
+				Severity: "warning",
+			})
+		}
 	}
 
 	if totalChecks > 0 {
@@ -310,59 +1039,88 @@ func (a *DevelopmentStandardsAgent) Validate(targetPath string, cfg *config.Conf
 	return result, nil
 }
 
-func (a *DevelopmentStandardsAgent) checkConventionalCommits(targetPath string, depth int) (bool, error) {
-	cmd := exec.Command("git", "log", "--oneline", fmt.Sprintf("-%d", depth))
-	cmd.Dir = targetPath
+// commitReport is the outcome of checking the recent commit history.
+// Excluded counts the ignored commits by kind.
+type commitReport struct {
+	Analysed  int
+	Compliant int
+	Excluded  map[string]int
+	Findings  []Finding
+}
 
-	output, err := cmd.Output()
-	if err != nil {
-		return false, fmt.Errorf("git log failed: %w", err)
+// ExcludedSummary describes the ignored commits, e.g. "2 merge, 1 fixup",
+// or returns "" when none were ignored.
+func (r commitReport) ExcludedSummary() string {
+	var parts []string
+	for _, kind := range []string{commitKindMerge, commitKindFixup, commitKindRevert} {
+		if count := r.Excluded[kind]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, kind))
+		}
 	}
+	return strings.Join(parts, ", ")
+}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) == 0 {
-		return true, nil
+// Passed reports whether the share of compliant commits meets threshold.
+func (r commitReport) Passed(threshold float64) bool {
+	if r.Analysed == 0 {
+		return true
 	}
+	return float64(r.Compliant) >= float64(r.Analysed)*threshold
+}
 
-	conventionalPattern := regexp.MustCompile(`^[a-f0-9]+ (feat|fix|docs|style|refactor|test|chore|perf|ci|build|revert)(\(.+\))?!?: .+`)
+func (a *DevelopmentStandardsAgent) checkConventionalCommits(ctx context.Context, targetPath string, agentCfg config.DevelopmentStandardsConfig) (commitReport, error) {
+	report := commitReport{Excluded: map[string]int{}}
 
-	validCommits := 0
-	for _, line := range lines {
-		if conventionalPattern.MatchString(line) {
-			validCommits++
-		}
+	commits, err := readCommitHistory(ctx, targetPath, agentCfg.CommitHistoryDepth)
+	if err != nil {
+		return report, err
 	}
 
-	threshold := float64(len(lines)) * 0.8
-	return float64(validCommits) >= threshold, nil
-}
+	analysis := agentCfg.CommitAnalysis
+	ignored := map[string]bool{
+		commitKindMerge:  analysis.IgnoreMergeCommits,
+		commitKindFixup:  analysis.IgnoreFixupCommits,
+		commitKindRevert: analysis.IgnoreRevertCommits,
+	}
 
-func (a *DevelopmentStandardsAgent) checkBranchNaming(targetPath string) (bool, string, error) {
-	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
-	cmd.Dir = targetPath
+	for _, commit := range commits {
+		if kind := commit.Kind(); ignored[kind] {
+			report.Excluded[kind]++
+			continue
+		}
 
-	output, err := cmd.Output()
-	if err != nil {
-		return false, "", fmt.Errorf("git branch check failed: %w", err)
+		report.Analysed++
+
+		violations := validateCommitMessage(commit.Message, agentCfg)
+		if len(violations) == 0 {
+			report.Compliant++
+			continue
+		}
+
+		for _, violation := range violations {
+			report.Findings = append(report.Findings, Finding{
+				Check:    "commit-messages",
+				Type:     "invalid",
+				File:     FileGitHistory,
+				Message:  fmt.Sprintf("Commit %s violates %s: %s", commit.ShortSHA(), violation.Rule, violation.Message),
+				Severity: "warning",
+			})
+		}
 	}
 
-	branchName := strings.TrimSpace(string(output))
+	return report, nil
+}
 
-	patterns := []*regexp.Regexp{
-		regexp.MustCompile(`^(feature|feat)/.+`),
-		regexp.MustCompile(`^(fix|bugfix)/.+`),
-		regexp.MustCompile(`^(hotfix|patch)/.+`),
-		regexp.MustCompile(`^(release|rel)/.+`),
-		regexp.MustCompile(`^(docs|documentation)/.+`),
-		regexp.MustCompile(`^(chore|task)/.+`),
-		regexp.MustCompile(`^(main|master|develop|development)$`),
+func (a *DevelopmentStandardsAgent) checkBranchNaming(ctx context.Context, targetPath string, namingCfg config.BranchNamingConfig) (branchMatch, error) {
+	matcher, err := newBranchMatcher(namingCfg)
+	if err != nil {
+		return branchMatch{}, err
 	}
 
-	for _, pattern := range patterns {
-		if pattern.MatchString(branchName) {
-			return true, branchName, nil
-		}
+	branchName, err := currentBranch(ctx, targetPath)
+	if err != nil {
+		return branchMatch{}, err
 	}
 
-	return false, branchName, nil
+	return matcher.Match(branchName), nil
 }
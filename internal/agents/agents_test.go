@@ -1,8 +1,13 @@
 package agents
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/codebase-interface/cli/internal/config"
@@ -177,3 +182,785 @@ func TestRegistry(t *testing.T) {
 		t.Errorf("Expected 1 agent in registry, got %d", len(all))
 	}
 }
+
+func TestEssentialFilesAgent_CustomFiles(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupFiles    []string
+		customFiles   []config.CustomFileConfig
+		expectedScore float64
+		expectedTypes []string
+	}{
+		{
+			name:       "root wildcard pattern matches",
+			setupFiles: []string{"README.md", "CONTRIBUTING.md", "LICENSE-MIT"},
+			customFiles: []config.CustomFileConfig{
+				{Pattern: "LICENSE*", Required: true, Description: "License file required"},
+			},
+			expectedScore: 1.0,
+			expectedTypes: []string{"present"},
+		},
+		{
+			name:       "nested pattern matches",
+			setupFiles: []string{"README.md", "CONTRIBUTING.md", "docs/guide/setup.md"},
+			customFiles: []config.CustomFileConfig{
+				{Pattern: "docs/**/*.md", Required: true},
+			},
+			expectedScore: 1.0,
+			expectedTypes: []string{"present"},
+		},
+		{
+			name:       "required pattern missing",
+			setupFiles: []string{"README.md", "CONTRIBUTING.md"},
+			customFiles: []config.CustomFileConfig{
+				{Pattern: "CODE_OF_CONDUCT*", Required: true, Description: "Code of conduct for community"},
+				{Pattern: "go.mod", Required: true},
+			},
+			expectedScore: 0.5,
+			expectedTypes: []string{"missing", "missing"},
+		},
+		{
+			name:       "optional pattern does not affect score",
+			setupFiles: []string{"README.md", "CONTRIBUTING.md"},
+			customFiles: []config.CustomFileConfig{
+				{Pattern: "SECURITY*", Required: false},
+			},
+			expectedScore: 1.0,
+			expectedTypes: []string{"missing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir, err := os.MkdirTemp("", "codebase-test-")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			for _, filename := range tt.setupFiles {
+				filePath := filepath.Join(tmpDir, filename)
+				if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+					t.Fatalf("Failed to create dir for %s: %v", filename, err)
+				}
+				if err := os.WriteFile(filePath, []byte("test content"), 0644); err != nil {
+					t.Fatalf("Failed to create test file %s: %v", filename, err)
+				}
+			}
+
+			cfg := config.DefaultConfig()
+			cfg.Validation.Agents.EssentialFiles.CustomFiles = tt.customFiles
+
+			result, err := NewEssentialFilesAgent().Validate(tmpDir, cfg)
+			if err != nil {
+				t.Fatalf("Validation failed: %v", err)
+			}
+
+			if result.Score != tt.expectedScore {
+				t.Errorf("Expected score %f, got %f", tt.expectedScore, result.Score)
+			}
+
+			// Each custom file is reported under its own check, whatever
+			// the other checks report around it.
+			for i, customFile := range tt.customFiles {
+				var matches []Finding
+				for _, finding := range result.Findings {
+					if finding.Check == "custom-file:"+customFile.Pattern {
+						matches = append(matches, finding)
+					}
+				}
+				if len(matches) != 1 {
+					t.Fatalf("Expected one finding for %s, got %+v", customFile.Pattern, matches)
+				}
+
+				finding := matches[0]
+				if finding.Type != tt.expectedTypes[i] {
+					t.Errorf("Finding %d: expected type %s, got %s", i, tt.expectedTypes[i], finding.Type)
+				}
+				if desc := tt.customFiles[i].Description; desc != "" && !strings.HasPrefix(finding.Message, desc) {
+					t.Errorf("Finding %d: expected message to carry description %q, got %q", i, desc, finding.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestEssentialFilesAgent_Cancelled(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 200; i++ {
+		sub := filepath.Join(dir, "pkg", fmt.Sprintf("module%03d", i))
+		if err := os.MkdirAll(sub, 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", sub, err)
+		}
+		for j := 0; j < 10; j++ {
+			if err := os.WriteFile(filepath.Join(sub, fmt.Sprintf("file%d.go", j)), nil, 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.EssentialFiles.CustomFiles = []config.CustomFileConfig{
+		{Pattern: "**/*.md", Required: true},
+	}
+
+	// A cancelled context stops the walk on its first entry instead of
+	// letting it visit the whole tree.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var agent ContextAgent = NewEssentialFilesAgent()
+	if _, err := agent.ValidateContext(ctx, dir, cfg); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the walk to stop with context.Canceled, got %v", err)
+	}
+
+	// Cancelled part way through, the walk stops where it is.
+	ctx = &cancelAfter{Context: context.Background(), remaining: 100}
+	if paths, err := listPaths(ctx, dir); !errors.Is(err, context.Canceled) || len(paths) >= 100 {
+		t.Errorf("Expected listPaths to stop after 100 entries with context.Canceled, got %d paths and %v", len(paths), err)
+	}
+}
+
+// cancelAfter is a context that reports itself cancelled once Err has been
+// called remaining times.
+type cancelAfter struct {
+	context.Context
+	remaining int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.remaining == 0 {
+		return context.Canceled
+	}
+	c.remaining--
+	return nil
+}
+
+func TestEssentialFilesAgent_CustomFilesManyMatches(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "docs"), 0755); err != nil {
+		t.Fatalf("Failed to create docs: %v", err)
+	}
+	for i := 1; i <= 8; i++ {
+		if err := os.WriteFile(filepath.Join(dir, "docs", fmt.Sprintf("guide%d.md", i)), nil, 0644); err != nil {
+			t.Fatalf("Failed to write guide: %v", err)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.EssentialFiles.CustomFiles = []config.CustomFileConfig{{Pattern: "docs/*.md", Required: true}}
+
+	result, err := NewEssentialFilesAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	expected := "docs/guide1.md, docs/guide2.md, docs/guide3.md, docs/guide4.md, docs/guide5.md and 3 more present"
+	for _, finding := range result.Findings {
+		if finding.Check == "custom-file:docs/*.md" {
+			if finding.Message != expected {
+				t.Errorf("Expected message %q, got %q", expected, finding.Message)
+			}
+			return
+		}
+	}
+	t.Error("Expected a finding for docs/*.md")
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"LICENSE*", "LICENSE", true},
+		{"LICENSE*", "LICENSE.md", true},
+		{"LICENSE*", "docs/LICENSE", false},
+		{"docs/**/*.md", "docs/usage.md", true},
+		{"docs/**/*.md", "docs/examples/README.md", true},
+		{"docs/**/*.md", "docs/examples/basic.yml", false},
+		{"**/*.go", "internal/agents/agents.go", true},
+		{"*.{md,rst}", "README.rst", true},
+		{"*.{md,rst}", "README.txt", false},
+		{"file?.txt", "file1.txt", true},
+		{"[!a]*.txt", "b.txt", true},
+		{"[!a]*.txt", "a.txt", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			if result := matchGlob(tt.pattern, tt.path); result != tt.expected {
+				t.Errorf("matchGlob(%q, %q) = %v, expected %v", tt.pattern, tt.path, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEssentialFilesAgent_Docs(t *testing.T) {
+	tests := []struct {
+		name              string
+		setupFiles        []string
+		docsDirectory     string
+		optionalDirectory bool
+		requirements      config.DocsRequirementsConfig
+		expectedScore     float64
+	}{
+		{
+			name:       "repository style docs layout",
+			setupFiles: []string{"docs/usage.md", "docs/configuration.md", "docs/installation.md", "docs/examples/basic.yml"},
+			requirements: config.DocsRequirementsConfig{
+				RequireUsageGuide: true,
+				RequireExamples:   true,
+				MinDocFiles:       3,
+			},
+			expectedScore: 1.0,
+		},
+		{
+			name:          "custom docs directory",
+			setupFiles:    []string{"documentation/guide.rst"},
+			docsDirectory: "documentation",
+			requirements: config.DocsRequirementsConfig{
+				RequireUsageGuide: true,
+				MinDocFiles:       1,
+			},
+			expectedScore: 1.0,
+		},
+		{
+			name:       "too few files and no examples",
+			setupFiles: []string{"docs/usage.md"},
+			requirements: config.DocsRequirementsConfig{
+				RequireUsageGuide: true,
+				RequireExamples:   true,
+				MinDocFiles:       3,
+			},
+			expectedScore: 0.5,
+		},
+		{
+			name:       "missing docs directory",
+			setupFiles: []string{},
+			requirements: config.DocsRequirementsConfig{
+				RequireUsageGuide: true,
+			},
+			expectedScore: 0.0,
+		},
+		{
+			name:              "missing optional docs directory",
+			setupFiles:        []string{},
+			optionalDirectory: true,
+			requirements: config.DocsRequirementsConfig{
+				RequireUsageGuide: true,
+				MinDocFiles:       2,
+			},
+			expectedScore: 0.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir, err := os.MkdirTemp("", "codebase-test-")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			for _, filename := range tt.setupFiles {
+				filePath := filepath.Join(tmpDir, filename)
+				if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+					t.Fatalf("Failed to create dir for %s: %v", filename, err)
+				}
+				if err := os.WriteFile(filePath, []byte("test content"), 0644); err != nil {
+					t.Fatalf("Failed to create test file %s: %v", filename, err)
+				}
+			}
+
+			cfg := config.DefaultConfig()
+			cfg.Validation.Agents.EssentialFiles.RequireReadme = false
+			cfg.Validation.Agents.EssentialFiles.RequireContributing = false
+			cfg.Validation.Agents.EssentialFiles.RequireDocsDirectory = !tt.optionalDirectory
+			cfg.Validation.Agents.EssentialFiles.DocsRequirements = tt.requirements
+			if tt.docsDirectory != "" {
+				cfg.Validation.Agents.EssentialFiles.DocsDirectory = tt.docsDirectory
+			}
+
+			result, err := NewEssentialFilesAgent().Validate(tmpDir, cfg)
+			if err != nil {
+				t.Fatalf("Validation failed: %v", err)
+			}
+
+			if result.Score != tt.expectedScore {
+				t.Errorf("Expected score %f, got %f (findings: %+v)", tt.expectedScore, result.Score, result.Findings)
+			}
+		})
+	}
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com",
+		"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1",
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, output)
+	}
+}
+
+// initGitRepo creates a repository on branch main with one empty commit per
+// message, oldest first.
+func initGitRepo(t *testing.T, messages ...string) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q", "-b", "main")
+	for _, message := range messages {
+		runGit(t, dir, "commit", "-q", "--allow-empty", "-m", message)
+	}
+	return dir
+}
+
+func TestDevelopmentStandardsAgent_CommitHistory(t *testing.T) {
+	dir := initGitRepo(t,
+		"feat(agents): add commit parser",
+		"fix: handle empty history",
+		"Update stuff quickly",
+		"wip: experiment with parsing",
+	)
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.DevelopmentStandards.ValidationThreshold = 0.5
+
+	result, err := NewDevelopmentStandardsAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	var violations []string
+	var summary Finding
+	for _, finding := range result.Findings {
+		if finding.File != "git-history" {
+			continue
+		}
+		if strings.HasPrefix(finding.Message, "Commit ") {
+			violations = append(violations, finding.Message)
+		} else {
+			summary = finding
+		}
+	}
+
+	if len(violations) != 2 {
+		t.Fatalf("Expected 2 per-commit violations, got %d: %v", len(violations), violations)
+	}
+
+	if !strings.Contains(violations[0], "allowed_types") {
+		t.Errorf("Expected newest commit to violate allowed_types, got %q", violations[0])
+	}
+
+	if summary.Severity != "info" || !strings.HasPrefix(summary.Message, "2 of 4") {
+		t.Errorf("Expected passing summary for 2 of 4 commits, got %+v", summary)
+	}
+}
+
+func TestDevelopmentStandardsAgent_GitErrors(t *testing.T) {
+	empty := initGitRepo(t)
+
+	result, err := NewDevelopmentStandardsAgent().Validate(empty, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("Expected a repository without commits to validate, got error: %v", err)
+	}
+	if result.Status == StatusError {
+		t.Errorf("Expected a repository without commits not to be an error, got %+v", result)
+	}
+
+	// git itself failing to run is an agent error.
+	t.Setenv("PATH", "")
+	_, err = NewDevelopmentStandardsAgent().Validate(empty, config.DefaultConfig())
+	if err == nil || !strings.Contains(err.Error(), "failed to check for a git repository") {
+		t.Errorf("Expected the git failure to be returned as an error, got %v", err)
+	}
+}
+
+func TestDevelopmentStandardsAgent_NotARepository(t *testing.T) {
+	result, err := NewDevelopmentStandardsAgent().Validate(t.TempDir(), config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("Expected a directory outside git to validate, got error: %v", err)
+	}
+
+	if result.Status != "fail" || result.Score != 0 {
+		t.Errorf("Expected the git checks to fail, got status %s and score %f", result.Status, result.Score)
+	}
+	for _, file := range []string{"git-history", "git-branch"} {
+		found := false
+		for _, finding := range result.Findings {
+			if finding.File == file && finding.Type == "missing" && finding.Severity == "warning" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected a missing finding for %s, got %+v", file, result.Findings)
+		}
+	}
+}
+
+func TestBranchMatcher(t *testing.T) {
+	defaults := config.DefaultConfig().Validation.Agents.DevelopmentStandards.BranchNaming
+
+	tests := []struct {
+		name            string
+		modify          func(cfg *config.BranchNamingConfig)
+		branch          string
+		expectedValid   bool
+		expectedPattern string
+		protected       bool
+	}{
+		{
+			name:            "default feature branch",
+			branch:          "feature/add-parser",
+			expectedValid:   true,
+			expectedPattern: "^(feature|feat)/.+",
+		},
+		{
+			name:          "protected branch",
+			branch:        "main",
+			expectedValid: true,
+			protected:     true,
+		},
+		{
+			name:          "default patterns ignore case",
+			branch:        "Feature/Add-Parser",
+			expectedValid: true,
+		},
+		{
+			name: "case sensitive patterns",
+			modify: func(cfg *config.BranchNamingConfig) {
+				cfg.CaseSensitivity = true
+			},
+			branch:        "Feature/Add-Parser",
+			expectedValid: false,
+		},
+		{
+			name: "custom JIRA convention",
+			modify: func(cfg *config.BranchNamingConfig) {
+				cfg.Patterns = []string{`^[A-Z]+-\d+/[a-z0-9-]+$`}
+			},
+			branch:          "JIRA-123/short-desc",
+			expectedValid:   true,
+			expectedPattern: `^[A-Z]+-\d+/[a-z0-9-]+$`,
+		},
+		{
+			name: "custom protected branch",
+			modify: func(cfg *config.BranchNamingConfig) {
+				cfg.ProtectedBranches = []string{"trunk"}
+			},
+			branch:        "trunk",
+			expectedValid: true,
+			protected:     true,
+		},
+		{
+			name: "too long",
+			modify: func(cfg *config.BranchNamingConfig) {
+				cfg.MaxLength = 10
+			},
+			branch:        "feature/very-long-name",
+			expectedValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namingCfg := defaults
+			if tt.modify != nil {
+				tt.modify(&namingCfg)
+			}
+
+			matcher, err := newBranchMatcher(namingCfg)
+			if err != nil {
+				t.Fatalf("newBranchMatcher failed: %v", err)
+			}
+
+			match := matcher.Match(tt.branch)
+			if match.Valid != tt.expectedValid {
+				t.Errorf("Expected valid %v, got %v (%s)", tt.expectedValid, match.Valid, match.Reason)
+			}
+			if match.Protected != tt.protected {
+				t.Errorf("Expected protected %v, got %v", tt.protected, match.Protected)
+			}
+			if tt.expectedPattern != "" && match.Pattern != tt.expectedPattern {
+				t.Errorf("Expected pattern %q, got %q", tt.expectedPattern, match.Pattern)
+			}
+		})
+	}
+}
+
+func TestBranchMatcher_InvalidPattern(t *testing.T) {
+	if _, err := newBranchMatcher(config.BranchNamingConfig{Patterns: []string{"("}}); err == nil {
+		t.Error("Expected error for invalid pattern")
+	}
+}
+
+func TestDevelopmentStandardsAgent_BranchValidationDisabled(t *testing.T) {
+	dir := initGitRepo(t, "feat: initial commit")
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.DevelopmentStandards.CheckCommitHistory = false
+	cfg.Validation.Agents.DevelopmentStandards.BranchValidation = false
+
+	result, err := NewDevelopmentStandardsAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	if len(result.Findings) != 0 {
+		t.Errorf("Expected no findings with branch validation disabled, got %+v", result.Findings)
+	}
+
+	if result.Score != 1.0 {
+		t.Errorf("Expected score 1.0, got %f", result.Score)
+	}
+}
+
+func TestCommitRecord_Kind(t *testing.T) {
+	tests := []struct {
+		record   commitRecord
+		expected string
+	}{
+		{commitRecord{Parents: []string{"a", "b"}, Message: "Merge pull request #1 from org/feature"}, commitKindMerge},
+		{commitRecord{Parents: []string{"a"}, Message: "fixup! feat: add parser"}, commitKindFixup},
+		{commitRecord{Parents: []string{"a"}, Message: "squash! feat: add parser"}, commitKindFixup},
+		{commitRecord{Parents: []string{"a"}, Message: "amend! feat: add parser"}, commitKindFixup},
+		{commitRecord{Parents: []string{"a"}, Message: "Revert \"feat: add parser\"\n\nThis reverts commit abc."}, commitKindRevert},
+		{commitRecord{Parents: []string{"a"}, Message: "revert: undo parser"}, commitKindRegular},
+		{commitRecord{Message: "feat: initial commit"}, commitKindRegular},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.record.Message, func(t *testing.T) {
+			if kind := tt.record.Kind(); kind != tt.expected {
+				t.Errorf("Expected kind %s, got %s", tt.expected, kind)
+			}
+		})
+	}
+}
+
+func TestDevelopmentStandardsAgent_IgnoredCommits(t *testing.T) {
+	dir := initGitRepo(t, "feat: initial commit")
+	runGit(t, dir, "checkout", "-q", "-b", "feature/parser")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "feat: add parser")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "fixup! feat: add parser")
+	runGit(t, dir, "checkout", "-q", "main")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "docs: describe parser")
+	runGit(t, dir, "merge", "-q", "--no-ff", "-m", "Merge branch 'feature/parser'", "feature/parser")
+
+	tests := []struct {
+		name             string
+		ignore           bool
+		expectedPrefix   string
+		expectedExcluded string
+	}{
+		{"ignored", true, "3 of 3", "excluded 1 merge, 1 fixup"},
+		{"counted", false, "3 of 5", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Validation.Agents.DevelopmentStandards.CommitAnalysis.IgnoreMergeCommits = tt.ignore
+			cfg.Validation.Agents.DevelopmentStandards.CommitAnalysis.IgnoreFixupCommits = tt.ignore
+
+			result, err := NewDevelopmentStandardsAgent().Validate(dir, cfg)
+			if err != nil {
+				t.Fatalf("Validation failed: %v", err)
+			}
+
+			var summary string
+			for _, finding := range result.Findings {
+				if finding.File == "git-history" && !strings.HasPrefix(finding.Message, "Commit ") {
+					summary = finding.Message
+				}
+			}
+
+			if !strings.HasPrefix(summary, tt.expectedPrefix) {
+				t.Errorf("Expected summary starting with %q, got %q", tt.expectedPrefix, summary)
+			}
+
+			if tt.expectedExcluded != "" && !strings.Contains(summary, tt.expectedExcluded) {
+				t.Errorf("Expected summary to report %q, got %q", tt.expectedExcluded, summary)
+			}
+			if tt.expectedExcluded == "" && strings.Contains(summary, "excluded") {
+				t.Errorf("Expected no excluded commits, got %q", summary)
+			}
+		})
+	}
+}
+
+func TestDevelopmentStandardsAgent_BreakingChangeFooter(t *testing.T) {
+	dir := initGitRepo(t,
+		"feat(api)!: drop v1 endpoints\n\nBREAKING CHANGE: clients must use /v2",
+		"feat(api)!: remove legacy flags",
+	)
+
+	cmd := exec.Command("git", "rev-parse", "--short=7", "HEAD")
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("git rev-parse failed: %v", err)
+	}
+	headSHA := strings.TrimSpace(string(output))
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.DevelopmentStandards.ConventionalCommits.RequireBreakingChangeFooter = true
+
+	result, err := NewDevelopmentStandardsAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	var violations []Finding
+	for _, finding := range result.Findings {
+		if strings.HasPrefix(finding.Message, "Commit ") {
+			violations = append(violations, finding)
+		}
+	}
+
+	if len(violations) != 1 {
+		t.Fatalf("Expected 1 violation, got %d: %+v", len(violations), violations)
+	}
+
+	expected := fmt.Sprintf("Commit %s violates require_breaking_change_footer", headSHA)
+	if !strings.HasPrefix(violations[0].Message, expected) {
+		t.Errorf("Expected message starting with %q, got %q", expected, violations[0].Message)
+	}
+}
+
+func TestMissingIgnorePatterns(t *testing.T) {
+	rules := []gitignoreRule{
+		{Pattern: "/bin/"},
+		{Pattern: "**/vendor"},
+		{Pattern: "*.exe"},
+		{Pattern: "*.test", Negated: true},
+	}
+
+	missing := missingIgnorePatterns(rules, []string{"*.exe", "*.test", "vendor/", "bin/", "*.out"})
+
+	expected := []string{"*.test", "*.out"}
+	if strings.Join(missing, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected missing %v, got %v", expected, missing)
+	}
+}
+
+func TestGitConfigurationAgent_GitignoreValidation(t *testing.T) {
+	dir := initGitRepo(t)
+
+	files := map[string]string{
+		".gitignore":    "# build output\n*.exe\n*.test\n/bin/\nnode_modules/\n*.log\n",
+		".editorconfig": "root = true\n",
+		"go.mod":        "module example.com/test\n",
+		"package.json":  "{}\n",
+		"debug.log":     "tracked by mistake\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	runGit(t, dir, "add", "-f", ".")
+
+	cfg := config.DefaultConfig()
+	gitCfg := &cfg.Validation.Agents.GitConfiguration
+	gitCfg.ValidationRules.GitignoreValidation = true
+	gitCfg.GitignoreValidation = config.GitignoreValidationConfig{
+		CheckLanguageSpecific: true,
+		DetectProjectType:     true,
+		RequiredPatterns: map[string][]string{
+			"go":      {"*.exe", "*.test", "*.out", "bin/"},
+			"rust":    {"target/"},
+			"general": {".DS_Store"},
+		},
+	}
+
+	result, err := NewGitConfigurationAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	var messages []string
+	for _, finding := range result.Findings {
+		messages = append(messages, finding.Message)
+	}
+	all := strings.Join(messages, "\n")
+
+	for _, expected := range []string{
+		".gitignore is missing go patterns: *.out",
+		".gitignore covers node patterns",
+		".gitignore is missing general patterns: .DS_Store",
+		"Tracked file debug.log matches .gitignore rules",
+	} {
+		if !strings.Contains(all, expected) {
+			t.Errorf("Expected finding %q, got:\n%s", expected, all)
+		}
+	}
+
+	if strings.Contains(all, "rust") {
+		t.Errorf("Rust patterns should not be checked when Cargo.toml is absent:\n%s", all)
+	}
+
+	// gitignore, editorconfig, node patterns pass; go patterns, general
+	// patterns and tracked files fail.
+	expectedScore := 3.0 / 6.0
+	if result.Score != expectedScore {
+		t.Errorf("Expected score %f, got %f", expectedScore, result.Score)
+	}
+}
+
+func TestFindings_Check(t *testing.T) {
+	dir := initGitRepo(t, "feat: initial commit")
+	for name, content := range map[string]string{"README.md": "# Project\n", ".gitignore": "*.log\n"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.EssentialFiles.RequireDocsDirectory = true
+	cfg.Validation.Agents.GitConfiguration.ValidationRules.GitignoreValidation = true
+
+	for _, agent := range []Agent{NewEssentialFilesAgent(), NewGitConfigurationAgent(), NewDevelopmentStandardsAgent()} {
+		result, err := agent.Validate(dir, cfg)
+		if err != nil {
+			t.Fatalf("Validate failed: %v", err)
+		}
+		for _, finding := range result.Findings {
+			if finding.Check == "" {
+				t.Errorf("Expected %s finding %q to name its check", result.Agent, finding.Message)
+			}
+		}
+	}
+}
+
+func TestFinding_NamesFile(t *testing.T) {
+	tests := []struct {
+		finding  Finding
+		expected bool
+	}{
+		{Finding{Type: "invalid", File: ".gitattributes"}, true},
+		{Finding{Type: "present", File: "docs/usage.md"}, true},
+		{Finding{Type: "missing", File: "CONTRIBUTING.md"}, false},
+		{Finding{Type: "invalid", File: "docs/"}, false},
+		{Finding{Type: "invalid", File: FileGitHistory}, false},
+		{Finding{Type: "invalid", File: FileGitBranch}, false},
+		{Finding{Type: "invalid"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.finding.NamesFile(); got != tt.expected {
+			t.Errorf("NamesFile() for %+v = %v, expected %v", tt.finding, got, tt.expected)
+		}
+	}
+}
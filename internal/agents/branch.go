@@ -0,0 +1,105 @@
+package agents
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// branchMatcher holds the compiled branch naming rules for one validation.
+type branchMatcher struct {
+	patterns      []*regexp.Regexp
+	sources       []string
+	protected     []string
+	caseSensitive bool
+	minLength     int
+	maxLength     int
+}
+
+// branchMatch describes how a branch name was judged.
+type branchMatch struct {
+	Branch    string
+	Valid     bool
+	Protected bool
+	Pattern   string
+	Reason    string
+}
+
+// newBranchMatcher compiles the configured patterns once. Patterns are
+// matched case-insensitively unless case_sensitivity is set.
+func newBranchMatcher(cfg config.BranchNamingConfig) (*branchMatcher, error) {
+	matcher := &branchMatcher{
+		protected:     cfg.ProtectedBranches,
+		caseSensitive: cfg.CaseSensitivity,
+		minLength:     cfg.MinLength,
+		maxLength:     cfg.MaxLength,
+	}
+
+	for _, source := range cfg.Patterns {
+		expr := source
+		if !cfg.CaseSensitivity {
+			expr = "(?i)" + expr
+		}
+
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid branch naming pattern %q: %w", source, err)
+		}
+
+		matcher.patterns = append(matcher.patterns, pattern)
+		matcher.sources = append(matcher.sources, source)
+	}
+
+	return matcher, nil
+}
+
+func (m *branchMatcher) Match(branch string) branchMatch {
+	match := branchMatch{Branch: branch}
+
+	for _, protected := range m.protected {
+		if protected == branch || (!m.caseSensitive && strings.EqualFold(protected, branch)) {
+			match.Valid = true
+			match.Protected = true
+			return match
+		}
+	}
+
+	length := len([]rune(branch))
+	if m.minLength > 0 && length < m.minLength {
+		match.Reason = fmt.Sprintf("shorter than %d characters", m.minLength)
+		return match
+	}
+	if m.maxLength > 0 && length > m.maxLength {
+		match.Reason = fmt.Sprintf("longer than %d characters", m.maxLength)
+		return match
+	}
+
+	for i, pattern := range m.patterns {
+		if pattern.MatchString(branch) {
+			match.Valid = true
+			match.Pattern = m.sources[i]
+			return match
+		}
+	}
+
+	match.Reason = "matches no configured pattern"
+	return match
+}
+
+// currentBranch returns the checked out branch, or "HEAD" when detached.
+// The branch of a repository without commits is read from the symbolic ref.
+func currentBranch(ctx context.Context, targetPath string) (string, error) {
+	output, err := gitOutput(ctx, targetPath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		symbolic, symbolicErr := gitOutput(ctx, targetPath, "symbolic-ref", "--short", "HEAD")
+		if symbolicErr != nil {
+			return "", err
+		}
+		output = symbolic
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,87 @@
+package agents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Commit kinds assigned by commitRecord.Kind.
+const (
+	commitKindRegular = "regular"
+	commitKindMerge   = "merge"
+	commitKindFixup   = "fixup"
+	commitKindRevert  = "revert"
+)
+
+var autosquashPrefixes = []string{"fixup!", "squash!", "amend!"}
+
+// commitRecord is a single commit read from the repository history.
+type commitRecord struct {
+	SHA     string
+	Parents []string
+	Message string
+}
+
+// Kind classifies the commit by its parent count and by the subject
+// prefixes git itself generates for autosquash and revert commits.
+func (c commitRecord) Kind() string {
+	if len(c.Parents) > 1 {
+		return commitKindMerge
+	}
+
+	subject, _, _ := strings.Cut(c.Message, "\n")
+	for _, prefix := range autosquashPrefixes {
+		if strings.HasPrefix(subject, prefix) {
+			return commitKindFixup
+		}
+	}
+	if strings.HasPrefix(subject, `Revert "`) {
+		return commitKindRevert
+	}
+
+	return commitKindRegular
+}
+
+// ShortSHA returns the abbreviated commit hash used in findings.
+func (c commitRecord) ShortSHA() string {
+	if len(c.SHA) > 7 {
+		return c.SHA[:7]
+	}
+	return c.SHA
+}
+
+// readCommitHistory returns the full messages of the last depth commits
+// reachable from HEAD, newest first. A repository without commits has an
+// empty history.
+func readCommitHistory(ctx context.Context, targetPath string, depth int) ([]commitRecord, error) {
+	if insideWorkTree(ctx, targetPath) && !hasCommits(ctx, targetPath) {
+		return nil, nil
+	}
+
+	output, err := gitOutput(ctx, targetPath, "log", fmt.Sprintf("-%d", depth), "--format=%H%x1f%P%x1f%B%x1e")
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []commitRecord
+	for _, entry := range strings.Split(string(output), "\x1e") {
+		entry = strings.TrimLeft(entry, "\n")
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.SplitN(entry, "\x1f", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected git log output: %q", entry)
+		}
+
+		commits = append(commits, commitRecord{
+			SHA:     fields[0],
+			Parents: strings.Fields(fields[1]),
+			Message: strings.TrimSpace(fields[2]),
+		})
+	}
+
+	return commits, nil
+}
@@ -0,0 +1,219 @@
+package agents
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// ErrNotConventional is returned by ParseConventionalCommit when the commit
+// header does not follow the "type(scope)!: subject" format.
+var ErrNotConventional = errors.New("header does not match 'type(scope): subject'")
+
+var (
+	conventionalHeaderPattern = regexp.MustCompile(`^([A-Za-z][\w-]*)(?:\(([^()\r\n]*)\))?(!)?: (\S.*)$`)
+	commitFooterPattern       = regexp.MustCompile(`^(BREAKING CHANGE|BREAKING-CHANGE|[\w-]+)(?:: | #)(.*)$`)
+)
+
+// ConventionalCommit is a commit message parsed according to the
+// Conventional Commits 1.0.0 specification. Breaking is set by either the
+// '!' marker in the header (BreakingMarker) or a BREAKING CHANGE footer.
+type ConventionalCommit struct {
+	Type           string
+	Scope          string
+	Breaking       bool
+	BreakingMarker bool
+	Subject        string
+	Header         string
+	Body           string
+	Footers        []CommitFooter
+}
+
+type CommitFooter struct {
+	Token string
+	Value string
+}
+
+// IsBreakingChange reports whether the footer announces a breaking change.
+func (f CommitFooter) IsBreakingChange() bool {
+	return f.Token == "BREAKING CHANGE" || f.Token == "BREAKING-CHANGE"
+}
+
+// ParseConventionalCommit parses a full commit message. The header must be
+// conventional; the body and footers are optional.
+func ParseConventionalCommit(message string) (ConventionalCommit, error) {
+	lines := strings.Split(strings.ReplaceAll(strings.TrimSpace(message), "\r\n", "\n"), "\n")
+
+	commit := ConventionalCommit{Header: lines[0]}
+
+	m := conventionalHeaderPattern.FindStringSubmatch(lines[0])
+	if m == nil {
+		return commit, ErrNotConventional
+	}
+
+	commit.Type = m[1]
+	commit.Scope = m[2]
+	commit.BreakingMarker = m[3] == "!"
+	commit.Breaking = commit.BreakingMarker
+	commit.Subject = strings.TrimSpace(m[4])
+
+	rest := lines[1:]
+	footerStart := findFooterStart(rest)
+
+	commit.Body = strings.TrimSpace(strings.Join(rest[:footerStart], "\n"))
+	commit.Footers = parseFooters(rest[footerStart:])
+
+	for _, footer := range commit.Footers {
+		if footer.IsBreakingChange() {
+			commit.Breaking = true
+		}
+	}
+
+	return commit, nil
+}
+
+// findFooterStart returns the index of the first line of the trailing
+// paragraph when that paragraph starts with a footer token, or len(lines)
+// when the message has no footers.
+func findFooterStart(lines []string) int {
+	start := len(lines)
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.TrimSpace(lines[i]) != "" {
+			continue
+		}
+		if i+1 < len(lines) && commitFooterPattern.MatchString(lines[i+1]) {
+			start = i + 1
+		}
+		break
+	}
+	return start
+}
+
+func parseFooters(lines []string) []CommitFooter {
+	var footers []CommitFooter
+	for _, line := range lines {
+		if m := commitFooterPattern.FindStringSubmatch(line); m != nil {
+			footers = append(footers, CommitFooter{Token: m[1], Value: m[2]})
+			continue
+		}
+		if len(footers) > 0 {
+			last := &footers[len(footers)-1]
+			last.Value = strings.TrimRight(last.Value+"\n"+line, "\n")
+		}
+	}
+	return footers
+}
+
+// BreakingChangeFooter returns the first BREAKING CHANGE footer, if any.
+func (c ConventionalCommit) BreakingChangeFooter() (CommitFooter, bool) {
+	for _, footer := range c.Footers {
+		if footer.IsBreakingChange() {
+			return footer, true
+		}
+	}
+	return CommitFooter{}, false
+}
+
+// commitViolation names the rule a commit breaks, using the configuration
+// key that enables the rule.
+type commitViolation struct {
+	Rule    string
+	Message string
+}
+
+// validateCommitMessage checks a commit message against the configured
+// conventional commit rules and returns every violation found.
+func validateCommitMessage(message string, agentCfg config.DevelopmentStandardsConfig) []commitViolation {
+	var violations []commitViolation
+	rules := agentCfg.ConventionalCommits
+	analysis := agentCfg.CommitAnalysis
+
+	commit, err := ParseConventionalCommit(message)
+
+	headerLength := len([]rune(commit.Header))
+	if analysis.MinMessageLength > 0 && headerLength < analysis.MinMessageLength {
+		violations = append(violations, commitViolation{
+			Rule:    "min_message_length",
+			Message: fmt.Sprintf("header is %d characters, minimum is %d", headerLength, analysis.MinMessageLength),
+		})
+	}
+	if analysis.MaxMessageLength > 0 && headerLength > analysis.MaxMessageLength {
+		violations = append(violations, commitViolation{
+			Rule:    "max_message_length",
+			Message: fmt.Sprintf("header is %d characters, maximum is %d", headerLength, analysis.MaxMessageLength),
+		})
+	}
+
+	if err != nil {
+		return append(violations, commitViolation{Rule: "format", Message: err.Error()})
+	}
+
+	if len(rules.AllowedTypes) > 0 && !containsFold(rules.AllowedTypes, commit.Type) {
+		violations = append(violations, commitViolation{
+			Rule:    "allowed_types",
+			Message: fmt.Sprintf("type '%s' is not one of %s", commit.Type, strings.Join(rules.AllowedTypes, ", ")),
+		})
+	}
+
+	if rules.RequireScope && commit.Scope == "" {
+		violations = append(violations, commitViolation{
+			Rule:    "require_scope",
+			Message: "scope is required",
+		})
+	}
+
+	if len(rules.Scopes) > 0 && commit.Scope != "" && !containsFold(rules.Scopes, commit.Scope) {
+		violations = append(violations, commitViolation{
+			Rule:    "scopes",
+			Message: fmt.Sprintf("scope '%s' is not one of %s", commit.Scope, strings.Join(rules.Scopes, ", ")),
+		})
+	}
+
+	breakingRule := ""
+	switch {
+	case rules.RequireBreakingChangeFooter:
+		breakingRule = "require_breaking_change_footer"
+	case analysis.CheckBreakingChanges:
+		breakingRule = "check_breaking_changes"
+	}
+
+	if breakingRule != "" {
+		_, hasFooter := commit.BreakingChangeFooter()
+		if commit.BreakingMarker && !hasFooter {
+			violations = append(violations, commitViolation{
+				Rule:    breakingRule,
+				Message: "header is marked breaking with '!' but has no BREAKING CHANGE footer",
+			})
+		}
+		if hasFooter && !commit.BreakingMarker {
+			violations = append(violations, commitViolation{
+				Rule:    breakingRule,
+				Message: "BREAKING CHANGE footer present but header is not marked with '!'",
+			})
+		}
+	}
+
+	return violations
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether values contains value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
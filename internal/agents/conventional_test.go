@@ -0,0 +1,202 @@
+package agents
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	message := `feat(parser)!: support multi-line footers
+
+Rework the footer parser so values may span
+several lines.
+
+Reviewed-by: Jane Doe
+Refs #123
+BREAKING CHANGE: footers are now returned in order
+and may contain newlines`
+
+	commit, err := ParseConventionalCommit(message)
+	if err != nil {
+		t.Fatalf("ParseConventionalCommit failed: %v", err)
+	}
+
+	if commit.Type != "feat" {
+		t.Errorf("Expected type 'feat', got %q", commit.Type)
+	}
+
+	if commit.Scope != "parser" {
+		t.Errorf("Expected scope 'parser', got %q", commit.Scope)
+	}
+
+	if !commit.Breaking {
+		t.Error("Expected commit to be breaking")
+	}
+
+	if commit.Subject != "support multi-line footers" {
+		t.Errorf("Unexpected subject: %q", commit.Subject)
+	}
+
+	if commit.Body != "Rework the footer parser so values may span\nseveral lines." {
+		t.Errorf("Unexpected body: %q", commit.Body)
+	}
+
+	if len(commit.Footers) != 3 {
+		t.Fatalf("Expected 3 footers, got %d: %+v", len(commit.Footers), commit.Footers)
+	}
+
+	if commit.Footers[1].Token != "Refs" || commit.Footers[1].Value != "123" {
+		t.Errorf("Unexpected '#' footer: %+v", commit.Footers[1])
+	}
+
+	if !commit.Footers[2].IsBreakingChange() || commit.Footers[2].Value != "footers are now returned in order\nand may contain newlines" {
+		t.Errorf("Unexpected breaking change footer: %+v", commit.Footers[2])
+	}
+}
+
+func TestParseConventionalCommit_Invalid(t *testing.T) {
+	tests := []string{
+		"Update README",
+		"feat:missing space",
+		"feat(scope: unbalanced",
+		": no type",
+	}
+
+	for _, message := range tests {
+		t.Run(message, func(t *testing.T) {
+			if _, err := ParseConventionalCommit(message); !errors.Is(err, ErrNotConventional) {
+				t.Errorf("Expected ErrNotConventional for %q, got %v", message, err)
+			}
+		})
+	}
+}
+
+func TestValidateCommitMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		message       string
+		modify        func(cfg *config.DevelopmentStandardsConfig)
+		expectedRules []string
+	}{
+		{
+			name:          "valid commit",
+			message:       "fix(agents): handle empty history",
+			expectedRules: nil,
+		},
+		{
+			name:          "type not allowed",
+			message:       "wip: half-done feature",
+			expectedRules: []string{"allowed_types"},
+		},
+		{
+			name:    "scope required",
+			message: "feat: add parser",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.ConventionalCommits.RequireScope = true
+			},
+			expectedRules: []string{"require_scope"},
+		},
+		{
+			name:    "scope matched ignoring case",
+			message: "feat(API): add parser",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.ConventionalCommits.Scopes = []string{"api", "Config"}
+			},
+			expectedRules: nil,
+		},
+		{
+			name:    "scope not allowed",
+			message: "feat(ui): add parser",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.ConventionalCommits.Scopes = []string{"agents", "config"}
+			},
+			expectedRules: []string{"scopes"},
+		},
+		{
+			name:    "header too long",
+			message: "feat(agents): add a very long commit header that keeps going well past the limit",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.CommitAnalysis.MaxMessageLength = 72
+			},
+			expectedRules: []string{"max_message_length"},
+		},
+		{
+			name:          "length limits disabled by default",
+			message:       "feat(agents): add a very long commit header that keeps going well past the limit",
+			expectedRules: nil,
+		},
+		{
+			name:          "type matched ignoring case",
+			message:       "Fix(agents): handle empty history",
+			expectedRules: nil,
+		},
+		{
+			name:    "allowed types configured in another case",
+			message: "fix(agents): handle empty history",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.ConventionalCommits.AllowedTypes = []string{"FEAT", "Fix"}
+			},
+			expectedRules: nil,
+		},
+		{
+			name:    "breaking marker without footer",
+			message: "feat(api)!: drop v1 endpoints",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.ConventionalCommits.RequireBreakingChangeFooter = true
+			},
+			expectedRules: []string{"require_breaking_change_footer"},
+		},
+		{
+			name:    "breaking marker with footer",
+			message: "feat(api)!: drop v1 endpoints\n\nBREAKING CHANGE: clients must use /v2",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.ConventionalCommits.RequireBreakingChangeFooter = true
+			},
+			expectedRules: nil,
+		},
+		{
+			name:    "footer without breaking marker",
+			message: "feat(api): drop v1 endpoints\n\nBREAKING-CHANGE: clients must use /v2",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.CommitAnalysis.CheckBreakingChanges = true
+			},
+			expectedRules: []string{"check_breaking_changes"},
+		},
+		{
+			name:          "breaking checks disabled",
+			message:       "feat(api)!: drop v1 endpoints",
+			expectedRules: nil,
+		},
+		{
+			name:    "not conventional and too short",
+			message: "wip",
+			modify: func(cfg *config.DevelopmentStandardsConfig) {
+				cfg.CommitAnalysis.MinMessageLength = 10
+			},
+			expectedRules: []string{"min_message_length", "format"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agentCfg := config.DefaultConfig().Validation.Agents.DevelopmentStandards
+			if tt.modify != nil {
+				tt.modify(&agentCfg)
+			}
+
+			violations := validateCommitMessage(tt.message, agentCfg)
+
+			if len(violations) != len(tt.expectedRules) {
+				t.Fatalf("Expected %d violations, got %d: %+v", len(tt.expectedRules), len(violations), violations)
+			}
+
+			for i, violation := range violations {
+				if violation.Rule != tt.expectedRules[i] {
+					t.Errorf("Violation %d: expected rule %s, got %s", i, tt.expectedRules[i], violation.Rule)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package agents
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	docFileExtensions = map[string]bool{
+		".md":       true,
+		".markdown": true,
+		".rst":      true,
+		".adoc":     true,
+		".txt":      true,
+	}
+	usageGuideNames = map[string]bool{
+		"usage":           true,
+		"user-guide":      true,
+		"user_guide":      true,
+		"guide":           true,
+		"getting-started": true,
+		"getting_started": true,
+	}
+	examplesDirNames = map[string]bool{
+		"examples": true,
+		"example":  true,
+	}
+)
+
+// docsInventory summarises the contents of a documentation directory.
+type docsInventory struct {
+	DocFiles    []string
+	UsageGuide  string
+	ExamplesDir string
+}
+
+// inventoryDocs walks docsPath and records its documentation files, the
+// first usage guide and the first non-empty examples directory. Paths are
+// relative to relativeTo and slash-separated. The walk stops with ctx's
+// error once ctx is done.
+func inventoryDocs(ctx context.Context, docsPath, relativeTo string) (docsInventory, error) {
+	var inventory docsInventory
+
+	err := filepath.WalkDir(docsPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(relativeTo, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if inventory.ExamplesDir == "" && examplesDirNames[strings.ToLower(d.Name())] && hasFiles(path) {
+				inventory.ExamplesDir = rel
+			}
+			return nil
+		}
+
+		ext := strings.ToLower(filepath.Ext(d.Name()))
+		if !docFileExtensions[ext] {
+			return nil
+		}
+
+		inventory.DocFiles = append(inventory.DocFiles, rel)
+		if inventory.UsageGuide == "" && usageGuideNames[strings.ToLower(strings.TrimSuffix(d.Name(), filepath.Ext(d.Name())))] {
+			inventory.UsageGuide = rel
+		}
+		return nil
+	})
+
+	return inventory, err
+}
+
+func hasFiles(dir string) bool {
+	found := false
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || found {
+			return filepath.SkipAll
+		}
+		if !d.IsDir() {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return found
+}
@@ -0,0 +1,342 @@
+package agents
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxEditorconfigViolations caps the per-file compliance findings so a badly
+// formatted tree does not drown the report.
+const maxEditorconfigViolations = 50
+
+var (
+	utf8BOM                 = []byte{0xEF, 0xBB, 0xBF}
+	spellingLanguagePattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]{2})?$`)
+)
+
+// editorconfigKeys maps each known property to a validator for its
+// (lower-cased) value. "unset" is accepted for every property.
+var editorconfigKeys = map[string]func(string) bool{
+	"indent_style":             oneOf("tab", "space"),
+	"indent_size":              func(v string) bool { return v == "tab" || isPositiveInt(v) },
+	"tab_width":                isPositiveInt,
+	"end_of_line":              oneOf("lf", "cr", "crlf"),
+	"charset":                  oneOf("latin1", "utf-8", "utf-8-bom", "utf-16be", "utf-16le"),
+	"trim_trailing_whitespace": oneOf("true", "false"),
+	"insert_final_newline":     oneOf("true", "false"),
+	"max_line_length":          func(v string) bool { return v == "off" || isPositiveInt(v) },
+	"spelling_language":        spellingLanguagePattern.MatchString,
+}
+
+type editorconfigProperty struct {
+	Key   string
+	Value string
+	Line  int
+}
+
+type editorconfigSection struct {
+	Glob       string
+	Line       int
+	Properties []editorconfigProperty
+}
+
+// editorconfigFile is a parsed .editorconfig. Preamble holds the properties
+// that appear before the first section.
+type editorconfigFile struct {
+	Preamble []editorconfigProperty
+	Sections []editorconfigSection
+}
+
+// editorconfigIssue is a semantic problem found while parsing.
+type editorconfigIssue struct {
+	Line    int
+	Message string
+}
+
+// parseEditorconfig parses the INI-style file and reports syntax errors,
+// unknown keys, invalid values and a missing "root = true". Every issue is
+// critical.
+func parseEditorconfig(content string) (editorconfigFile, []editorconfigIssue) {
+	var file editorconfigFile
+	var issues []editorconfigIssue
+	var current *editorconfigSection
+	rootDeclared := false
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, string(utf8BOM))
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || len(line) < 3 {
+				issues = append(issues, editorconfigIssue{Line: lineNumber, Message: fmt.Sprintf("malformed section header %q", line)})
+				continue
+			}
+			file.Sections = append(file.Sections, editorconfigSection{Glob: line[1 : len(line)-1], Line: lineNumber})
+			current = &file.Sections[len(file.Sections)-1]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			issues = append(issues, editorconfigIssue{Line: lineNumber, Message: fmt.Sprintf("expected 'key = value', got %q", line)})
+			continue
+		}
+
+		property := editorconfigProperty{
+			Key:   strings.ToLower(strings.TrimSpace(key)),
+			Value: strings.ToLower(strings.TrimSpace(value)),
+			Line:  lineNumber,
+		}
+
+		if property.Key == "root" {
+			if current != nil {
+				issues = append(issues, editorconfigIssue{Line: lineNumber, Message: "'root' must appear before the first section"})
+			} else if property.Value != "true" && property.Value != "false" {
+				issues = append(issues, editorconfigIssue{Line: lineNumber, Message: fmt.Sprintf("invalid value %q for root (expected true or false)", property.Value)})
+			} else {
+				rootDeclared = property.Value == "true"
+			}
+			file.Preamble = append(file.Preamble, property)
+			continue
+		}
+
+		validate, known := editorconfigKeys[property.Key]
+		switch {
+		case !known:
+			issues = append(issues, editorconfigIssue{Line: lineNumber, Message: fmt.Sprintf("unknown property %q", property.Key)})
+		case property.Value != "unset" && !validate(property.Value):
+			issues = append(issues, editorconfigIssue{Line: lineNumber, Message: fmt.Sprintf("invalid value %q for %s", property.Value, property.Key)})
+		}
+
+		if current == nil {
+			issues = append(issues, editorconfigIssue{Line: lineNumber, Message: fmt.Sprintf("property %q appears outside of a section", property.Key)})
+			continue
+		}
+		current.Properties = append(current.Properties, property)
+	}
+
+	if !rootDeclared {
+		issues = append(issues, editorconfigIssue{Line: 1, Message: "missing 'root = true' declaration"})
+	}
+
+	return file, issues
+}
+
+// PropertiesFor resolves the properties that apply to the slash-separated
+// path. Sections are applied in order, so later sections win. Globs without
+// a '/' match the file name in any directory.
+func (f editorconfigFile) PropertiesFor(path string) map[string]string {
+	properties := map[string]string{}
+	for _, section := range f.Sections {
+		glob := section.Glob
+		if strings.Contains(glob, "/") {
+			glob = strings.TrimPrefix(glob, "/")
+		} else {
+			glob = "**/" + glob
+		}
+		if !matchGlob(glob, path) {
+			continue
+		}
+		for _, property := range section.Properties {
+			properties[property.Key] = property.Value
+		}
+	}
+	for key, value := range properties {
+		if value == "unset" {
+			delete(properties, key)
+		}
+	}
+	return properties
+}
+
+// editorconfigViolation is a file that breaks one property. Line is the
+// first offending line and Count the number of offending lines.
+type editorconfigViolation struct {
+	File     string
+	Property string
+	Line     int
+	Count    int
+	Message  string
+}
+
+// checkEditorconfigCompliance inspects file content against the resolved
+// properties. Binary files are skipped.
+func checkEditorconfigCompliance(path string, content []byte, properties map[string]string) []editorconfigViolation {
+	if len(content) == 0 || isBinary(content) {
+		return nil
+	}
+
+	var violations []editorconfigViolation
+	add := func(property string, line, count int, message string) {
+		violations = append(violations, editorconfigViolation{File: path, Property: property, Line: line, Count: count, Message: message})
+	}
+
+	switch properties["charset"] {
+	case "utf-8":
+		if bytes.HasPrefix(content, utf8BOM) {
+			add("charset", 1, 1, "file starts with a UTF-8 BOM but charset is utf-8")
+		} else if !utf8.Valid(content) {
+			add("charset", firstInvalidUTF8Line(content), 1, "file is not valid UTF-8")
+		}
+	case "utf-8-bom":
+		if !bytes.HasPrefix(content, utf8BOM) {
+			add("charset", 1, 1, "file is missing the UTF-8 BOM required by charset utf-8-bom")
+		}
+	}
+
+	if properties["insert_final_newline"] == "true" && content[len(content)-1] != '\n' {
+		add("insert_final_newline", bytes.Count(content, []byte("\n"))+1, 1, "file does not end with a newline")
+	}
+
+	lines := strings.Split(string(content), "\n")
+	if strings.HasSuffix(string(content), "\n") {
+		lines = lines[:len(lines)-1]
+	}
+
+	indentSize := 0
+	if size, err := strconv.Atoi(properties["indent_size"]); err == nil {
+		indentSize = size
+	} else if width, err := strconv.Atoi(properties["tab_width"]); err == nil {
+		indentSize = width
+	}
+	if indentSize == 0 {
+		indentSize = 2
+	}
+
+	type tally struct{ first, count int }
+	tallies := map[string]*tally{}
+	record := func(property string, lineNumber int) {
+		if t, ok := tallies[property]; ok {
+			t.count++
+			return
+		}
+		tallies[property] = &tally{first: lineNumber, count: 1}
+	}
+
+	for i, line := range lines {
+		lineNumber := i + 1
+		hasCR := strings.HasSuffix(line, "\r")
+		text := strings.TrimSuffix(line, "\r")
+
+		switch properties["end_of_line"] {
+		case "lf":
+			if hasCR {
+				record("end_of_line", lineNumber)
+			}
+		case "crlf":
+			if !hasCR {
+				record("end_of_line", lineNumber)
+			}
+		}
+
+		if properties["trim_trailing_whitespace"] == "true" && text != strings.TrimRight(text, " \t") {
+			record("trim_trailing_whitespace", lineNumber)
+		}
+
+		indent := text[:len(text)-len(strings.TrimLeft(text, " \t"))]
+		switch properties["indent_style"] {
+		case "space":
+			if strings.Contains(indent, "\t") {
+				record("indent_style", lineNumber)
+			}
+		case "tab":
+			if strings.HasPrefix(indent, strings.Repeat(" ", indentSize)) {
+				record("indent_style", lineNumber)
+			}
+		}
+	}
+
+	messages := map[string]string{
+		"end_of_line":              "line endings do not match end_of_line = " + properties["end_of_line"],
+		"trim_trailing_whitespace": "trailing whitespace",
+		"indent_style":             "indentation does not use " + properties["indent_style"] + "s",
+	}
+	for _, property := range []string{"indent_style", "end_of_line", "trim_trailing_whitespace"} {
+		if t, ok := tallies[property]; ok {
+			add(property, t.first, t.count, messages[property])
+		}
+	}
+
+	return violations
+}
+
+// editorconfigViolations checks every project file below targetPath against
+// the properties that apply to it.
+func editorconfigViolations(ctx context.Context, targetPath string, file editorconfigFile) ([]editorconfigViolation, error) {
+	files, err := projectFiles(ctx, targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []editorconfigViolation
+	for _, path := range files {
+		properties := file.PropertiesFor(path)
+		if len(properties) == 0 {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(targetPath, path))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		violations = append(violations, checkEditorconfigCompliance(path, content, properties)...)
+	}
+
+	return violations, nil
+}
+
+// isBinary uses git's heuristic: a NUL byte in the first 8000 bytes.
+func isBinary(content []byte) bool {
+	if len(content) > 8000 {
+		content = content[:8000]
+	}
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+func firstInvalidUTF8Line(content []byte) int {
+	line := 1
+	for len(content) > 0 {
+		r, size := utf8.DecodeRune(content)
+		if r == utf8.RuneError && size == 1 {
+			return line
+		}
+		if r == '\n' {
+			line++
+		}
+		content = content[size:]
+	}
+	return line
+}
+
+func oneOf(values ...string) func(string) bool {
+	return func(v string) bool {
+		for _, value := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func isPositiveInt(v string) bool {
+	n, err := strconv.Atoi(v)
+	return err == nil && n > 0
+}
@@ -0,0 +1,178 @@
+package agents
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+func TestParseEditorconfig(t *testing.T) {
+	content := strings.Join([]string{
+		"root = true",
+		"",
+		"[*]",
+		"indent_style = space",
+		"indent_size = 4",
+		"end_of_line = lf",
+		"charset = utf-8",
+		"",
+		"[*.go]",
+		"indent_style = tab",
+		"indent_size = unset",
+		"",
+		"[Makefile]",
+		"indent_style = tabs",
+		"colour = blue",
+		"broken line",
+	}, "\n")
+
+	file, issues := parseEditorconfig(content)
+
+	if len(file.Sections) != 3 {
+		t.Fatalf("Expected 3 sections, got %d", len(file.Sections))
+	}
+
+	expected := []struct {
+		line    int
+		message string
+	}{
+		{14, `invalid value "tabs" for indent_style`},
+		{15, `unknown property "colour"`},
+		{16, `expected 'key = value', got "broken line"`},
+	}
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %+v", len(expected), issues)
+	}
+	for i, want := range expected {
+		if issues[i].Line != want.line || issues[i].Message != want.message {
+			t.Errorf("Issue %d: expected line %d %q, got line %d %q", i, want.line, want.message, issues[i].Line, issues[i].Message)
+		}
+	}
+
+	properties := file.PropertiesFor("cmd/main.go")
+	if properties["indent_style"] != "tab" {
+		t.Errorf("Expected later section to override indent_style, got %q", properties["indent_style"])
+	}
+	if _, ok := properties["indent_size"]; ok {
+		t.Errorf("Expected unset indent_size to be removed, got %q", properties["indent_size"])
+	}
+	if properties["charset"] != "utf-8" {
+		t.Errorf("Expected charset from [*] section, got %q", properties["charset"])
+	}
+}
+
+func TestParseEditorconfig_MissingRoot(t *testing.T) {
+	_, issues := parseEditorconfig("[*]\nindent_style = space\n")
+
+	if len(issues) != 1 || issues[0].Message != "missing 'root = true' declaration" {
+		t.Errorf("Expected missing root issue, got %+v", issues)
+	}
+}
+
+func TestCheckEditorconfigCompliance(t *testing.T) {
+	properties := map[string]string{
+		"indent_style":             "space",
+		"end_of_line":              "lf",
+		"trim_trailing_whitespace": "true",
+		"insert_final_newline":     "true",
+		"charset":                  "utf-8",
+	}
+
+	tests := []struct {
+		name     string
+		content  string
+		property string
+		line     int
+		count    int
+	}{
+		{"clean", "a\n  b\n", "", 0, 0},
+		{"tab indent", "a\n\tb\n\tc\n", "indent_style", 2, 2},
+		{"crlf", "a\r\nb\n", "end_of_line", 1, 1},
+		{"trailing whitespace", "a\nb \n", "trim_trailing_whitespace", 2, 1},
+		{"no final newline", "a\nb", "insert_final_newline", 2, 1},
+		{"bom", "\xEF\xBB\xBFa\n", "charset", 1, 1},
+		{"binary", "a\x00b", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := checkEditorconfigCompliance("file.txt", []byte(tt.content), properties)
+
+			if tt.property == "" {
+				if len(violations) != 0 {
+					t.Errorf("Expected no violations, got %+v", violations)
+				}
+				return
+			}
+
+			if len(violations) != 1 {
+				t.Fatalf("Expected 1 violation, got %+v", violations)
+			}
+			v := violations[0]
+			if v.Property != tt.property || v.Line != tt.line || v.Count != tt.count {
+				t.Errorf("Expected %s at line %d (x%d), got %+v", tt.property, tt.line, tt.count, v)
+			}
+		})
+	}
+}
+
+func TestGitConfigurationAgent_EditorconfigValidation(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		".gitignore":    "*.log\n",
+		".editorconfig": "root = true\n\n[*]\ninsert_final_newline = true\ntrim_trailing_whitespace = true\n\n[*.go]\nindent_style = tab\n",
+		"main.go":       "package main\n\nfunc main() {\n    println()\n}\n",
+		"notes.txt":     "no newline at end",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	gitCfg := &cfg.Validation.Agents.GitConfiguration
+	gitCfg.ValidationRules.EditorconfigValidation = true
+
+	result, err := NewGitConfigurationAgent().Validate(tmpDir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+	if result.Score != 1.0 {
+		t.Errorf("Expected compliance to be skipped without check_file_compliance, got score %f", result.Score)
+	}
+
+	gitCfg.EditorconfigValidation.CheckFileCompliance = true
+
+	result, err = NewGitConfigurationAgent().Validate(tmpDir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	var violations []Finding
+	for _, finding := range result.Findings {
+		if finding.Type == "invalid" {
+			violations = append(violations, finding)
+		}
+	}
+	if len(violations) != 2 {
+		t.Fatalf("Expected 2 violations, got %+v", violations)
+	}
+
+	if violations[0].File != "main.go" || violations[0].Line != 4 {
+		t.Errorf("Expected indent_style violation at main.go:4, got %+v", violations[0])
+	}
+	if violations[1].File != "notes.txt" || violations[1].Line != 1 {
+		t.Errorf("Expected final newline violation at notes.txt:1, got %+v", violations[1])
+	}
+
+	// gitignore, editorconfig and its semantics pass; compliance fails.
+	expectedScore := 3.0 / 4.0
+	if result.Score != expectedScore {
+		t.Errorf("Expected score %f, got %f", expectedScore, result.Score)
+	}
+}
@@ -0,0 +1,68 @@
+package agents
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// gitOutput runs git in targetPath and returns its standard output. When git
+// fails, the error carries git's own message rather than just the exit
+// status.
+func gitOutput(ctx context.Context, targetPath string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = targetPath
+
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(bytes.TrimSpace(exitErr.Stderr)) > 0 {
+			return nil, fmt.Errorf("git %s failed: %s", args[0], strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("git %s failed: %w", args[0], err)
+	}
+
+	return output, nil
+}
+
+// insideWorkTree reports whether targetPath is inside a git work tree.
+func insideWorkTree(ctx context.Context, targetPath string) bool {
+	_, err := gitOutput(ctx, targetPath, "rev-parse", "--is-inside-work-tree")
+	return err == nil
+}
+
+// hasCommits reports whether HEAD points at a commit, which is not the case
+// in a repository without commits yet.
+func hasCommits(ctx context.Context, targetPath string) bool {
+	_, err := gitOutput(ctx, targetPath, "rev-parse", "--verify", "--quiet", "HEAD")
+	return err == nil
+}
+
+// checkWorkTree reports whether targetPath is inside a git work tree. Unlike
+// insideWorkTree it tells a directory outside any repository apart from git
+// failing to run, such as a missing binary or a cancelled context, which it
+// returns as an error.
+func checkWorkTree(ctx context.Context, targetPath string) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--is-inside-work-tree")
+	cmd.Dir = targetPath
+	// Keep git's messages in English so they can be recognised.
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+
+	output, err := cmd.Output()
+	if err == nil {
+		return strings.TrimSpace(string(output)) == "true", nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil && bytes.Contains(exitErr.Stderr, []byte("not a git repository")) {
+		return false, nil
+	}
+	if errors.As(err, &exitErr) && len(bytes.TrimSpace(exitErr.Stderr)) > 0 {
+		return false, fmt.Errorf("git rev-parse failed: %s", strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return false, fmt.Errorf("git rev-parse failed: %w", err)
+}
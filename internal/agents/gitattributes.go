@@ -0,0 +1,233 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Attribute states stored by parseGitattributes. Any other value is the
+// string assigned with "attr=value".
+const (
+	attributeSet   = "set"
+	attributeUnset = "unset"
+)
+
+// gitattributesMacros expands the built-in macro attributes.
+var gitattributesMacros = map[string][]string{
+	"binary": {"-diff", "-merge", "-text"},
+}
+
+type gitattributesRule struct {
+	Pattern    string
+	Attributes map[string]string
+	Line       int
+
+	// matcher is Pattern compiled once for matching paths, or nil when the
+	// rule can never match a file.
+	matcher *regexp.Regexp
+}
+
+// parseGitattributes reads the rules from a .gitattributes file. Macro
+// definitions ("[attr]name ...") are skipped; the built-in binary macro is
+// expanded. "!attr" is stored as an empty value, which resets the attribute
+// to unspecified.
+func parseGitattributes(path string) ([]gitattributesRule, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var rules []gitattributesRule
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "[attr]") {
+			continue
+		}
+
+		rule := gitattributesRule{Pattern: fields[0], Attributes: map[string]string{}, Line: lineNumber}
+		rule.matcher = compileAttributesPattern(rule.Pattern)
+		for _, attr := range fields[1:] {
+			if expansion, ok := gitattributesMacros[attr]; ok {
+				rule.Attributes[attr] = attributeSet
+				for _, expanded := range expansion {
+					rule.Attributes[strings.TrimPrefix(expanded, "-")] = attributeUnset
+				}
+				continue
+			}
+
+			switch {
+			case strings.HasPrefix(attr, "-"):
+				rule.Attributes[attr[1:]] = attributeUnset
+			case strings.HasPrefix(attr, "!"):
+				rule.Attributes[attr[1:]] = ""
+			default:
+				name, value, ok := strings.Cut(attr, "=")
+				if !ok {
+					value = attributeSet
+				}
+				rule.Attributes[name] = value
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, scanner.Err()
+}
+
+// compileAttributesPattern compiles a .gitattributes pattern. Patterns
+// without a '/' match the file name in any directory, as in git, and
+// directory patterns never match files, so they compile to nil.
+func compileAttributesPattern(pattern string) *regexp.Regexp {
+	if strings.HasSuffix(pattern, "/") {
+		return nil
+	}
+	if strings.Contains(pattern, "/") {
+		pattern = strings.TrimPrefix(pattern, "/")
+	} else {
+		pattern = "**/" + pattern
+	}
+
+	re, err := compileGlob(pattern)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+// attributesFor resolves the attributes of the slash-separated path. Later
+// rules win.
+func attributesFor(rules []gitattributesRule, file string) map[string]string {
+	attributes := map[string]string{}
+	for _, rule := range rules {
+		if rule.matcher == nil || !rule.matcher.MatchString(file) {
+			continue
+		}
+
+		for name, value := range rule.Attributes {
+			if value == "" {
+				delete(attributes, name)
+				continue
+			}
+			attributes[name] = value
+		}
+	}
+	return attributes
+}
+
+// normalizesLineEndings reports whether the rules declare line-ending
+// handling: either "* text=auto" (or "* text") or an explicit eol rule.
+func normalizesLineEndings(rules []gitattributesRule) bool {
+	for _, rule := range rules {
+		if _, ok := rule.Attributes["eol"]; ok {
+			return true
+		}
+		if rule.Pattern == "*" && (rule.Attributes["text"] == "auto" || rule.Attributes["text"] == attributeSet) {
+			return true
+		}
+	}
+	return false
+}
+
+// handlesBinary reports whether resolved attributes keep git from treating
+// a file as text: it is marked binary, -text or stored with LFS.
+func handlesBinary(attributes map[string]string) bool {
+	return attributes["binary"] == attributeSet ||
+		attributes["text"] == attributeUnset ||
+		attributes["filter"] == "lfs"
+}
+
+// fileExtension returns the lower-cased extension of file without the dot.
+func fileExtension(file string) string {
+	return strings.ToLower(strings.TrimPrefix(path.Ext(file), "."))
+}
+
+// normalizeExtensions lower-cases configured extensions and drops a leading
+// dot, so that ".PNG" matches what fileExtension returns for logo.png.
+func normalizeExtensions(extensions []string) []string {
+	normalized := make([]string, len(extensions))
+	for i, extension := range extensions {
+		normalized[i] = strings.ToLower(strings.TrimPrefix(extension, "."))
+	}
+	return normalized
+}
+
+// eolInfo is one line of "git ls-files --eol": the line endings git found
+// in the index and the working tree, and the attributes that apply.
+type eolInfo struct {
+	Path     string
+	Index    string
+	Worktree string
+	Attr     string
+}
+
+// readEOLInfo lists the line endings of every tracked file. It returns false
+// when targetPath is not inside a git work tree.
+func readEOLInfo(ctx context.Context, targetPath string) ([]eolInfo, bool, error) {
+	if !insideWorkTree(ctx, targetPath) {
+		return nil, false, nil
+	}
+
+	output, err := gitOutput(ctx, targetPath, "ls-files", "--eol", "-z")
+	if err != nil {
+		return nil, true, err
+	}
+
+	var infos []eolInfo
+	for _, entry := range strings.Split(string(output), "\x00") {
+		head, file, ok := strings.Cut(entry, "\t")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(head)
+		if len(fields) < 2 {
+			return nil, true, fmt.Errorf("unexpected git ls-files --eol output: %q", entry)
+		}
+		infos = append(infos, eolInfo{
+			Path:     file,
+			Index:    strings.TrimPrefix(fields[0], "i/"),
+			Worktree: strings.TrimPrefix(fields[1], "w/"),
+			Attr:     strings.TrimPrefix(strings.Join(fields[2:], " "), "attr/"),
+		})
+	}
+	return infos, true, nil
+}
+
+// Contradiction describes how the file's actual line endings disagree with
+// its attributes, or returns "" when they agree.
+func (i eolInfo) Contradiction() string {
+	var text bool
+	var eol string
+	for _, attr := range strings.Fields(i.Attr) {
+		switch {
+		case attr == "text" || attr == "text=auto":
+			text = true
+		case strings.HasPrefix(attr, "eol="):
+			text = true
+			eol = strings.TrimPrefix(attr, "eol=")
+		}
+	}
+	if !text {
+		return ""
+	}
+
+	if i.Index == "crlf" || i.Index == "mixed" {
+		return fmt.Sprintf("%s is committed with %s line endings but .gitattributes declares %s; run 'git add --renormalize .'", i.Path, strings.ToUpper(i.Index), i.Attr)
+	}
+
+	switch {
+	case eol == "lf" && (i.Worktree == "crlf" || i.Worktree == "mixed"):
+		return fmt.Sprintf("%s has %s line endings in the working tree but .gitattributes declares eol=lf", i.Path, strings.ToUpper(i.Worktree))
+	case eol == "crlf" && (i.Worktree == "lf" || i.Worktree == "mixed"):
+		return fmt.Sprintf("%s has %s line endings in the working tree but .gitattributes declares eol=crlf", i.Path, strings.ToUpper(i.Worktree))
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+package agents
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+func TestAttributesFor(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".gitattributes")
+	content := strings.Join([]string{
+		"# defaults",
+		"* text=auto",
+		"*.png binary",
+		"assets/** filter=lfs",
+		"assets/keep.txt !filter",
+		"[attr]custom -diff",
+		"/docs/*.md eol=lf",
+	}, "\n")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write .gitattributes: %v", err)
+	}
+
+	rules, err := parseGitattributes(path)
+	if err != nil {
+		t.Fatalf("Failed to parse .gitattributes: %v", err)
+	}
+	if len(rules) != 5 {
+		t.Fatalf("Expected 5 rules, got %d", len(rules))
+	}
+
+	tests := []struct {
+		path     string
+		expected map[string]string
+	}{
+		{"main.go", map[string]string{"text": "auto"}},
+		{"img/logo.png", map[string]string{"text": "unset", "binary": "set", "diff": "unset", "merge": "unset"}},
+		{"assets/data.bin", map[string]string{"text": "auto", "filter": "lfs"}},
+		{"assets/keep.txt", map[string]string{"text": "auto"}},
+		{"docs/guide.md", map[string]string{"text": "auto", "eol": "lf"}},
+		{"sub/docs/guide.md", map[string]string{"text": "auto"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			attributes := attributesFor(rules, tt.path)
+			if len(attributes) != len(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, attributes)
+			}
+			for name, value := range tt.expected {
+				if attributes[name] != value {
+					t.Errorf("Expected %s=%s, got %v", name, value, attributes)
+				}
+			}
+		})
+	}
+
+	if !normalizesLineEndings(rules) {
+		t.Error("Expected '* text=auto' to normalize line endings")
+	}
+	if normalizesLineEndings(rules[1:3]) {
+		t.Error("Expected rules without text=auto or eol not to normalize line endings")
+	}
+}
+
+func TestEOLInfo_Contradiction(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     eolInfo
+		expected string
+	}{
+		{"normalized", eolInfo{Path: "a.txt", Index: "lf", Worktree: "crlf", Attr: "text=auto"}, ""},
+		{"binary", eolInfo{Path: "a.png", Index: "-text", Worktree: "-text", Attr: "-text"}, ""},
+		{"no attributes", eolInfo{Path: "a.txt", Index: "crlf", Worktree: "crlf"}, ""},
+		{"committed crlf", eolInfo{Path: "a.txt", Index: "crlf", Worktree: "crlf", Attr: "text=auto"}, "a.txt is committed with CRLF line endings"},
+		{"worktree crlf", eolInfo{Path: "a.sh", Index: "lf", Worktree: "crlf", Attr: "text eol=lf"}, "a.sh has CRLF line endings in the working tree"},
+		{"worktree lf", eolInfo{Path: "a.bat", Index: "lf", Worktree: "lf", Attr: "text=auto eol=crlf"}, "a.bat has LF line endings in the working tree"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := tt.info.Contradiction()
+			if tt.expected == "" && message != "" {
+				t.Errorf("Expected no contradiction, got %q", message)
+			}
+			if !strings.HasPrefix(message, tt.expected) {
+				t.Errorf("Expected message starting with %q, got %q", tt.expected, message)
+			}
+		})
+	}
+}
+
+func TestGitConfigurationAgent_GitattributesValidation(t *testing.T) {
+	dir := initGitRepo(t)
+
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	// Committed before .gitattributes exists, so the CRLF endings stay in
+	// the index.
+	write("legacy.txt", "one\r\ntwo\r\n")
+	runGit(t, dir, "add", ".")
+
+	write(".gitattributes", "* text=auto\n*.sh eol=lf\n*.jar filter=lfs\n")
+	write("build.sh", "echo one\r\n")
+	write("lib.jar", "PK\x00\x03")
+	write("logo.png", "\x89PNG\x00")
+	write("icon.png", "\x89PNG\x00")
+	runGit(t, dir, "add", ".gitattributes", "build.sh", "lib.jar", "logo.png", "icon.png")
+
+	cfg := config.DefaultConfig()
+	gitCfg := &cfg.Validation.Agents.GitConfiguration
+	gitCfg.RequireGitignore = false
+	gitCfg.RequireEditorconfig = false
+	gitCfg.ValidationRules.GitattributesValidation = true
+
+	result, err := NewGitConfigurationAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	var messages []string
+	for _, finding := range result.Findings {
+		messages = append(messages, finding.Message)
+	}
+	all := strings.Join(messages, "\n")
+
+	for _, expected := range []string{
+		".gitattributes normalizes line endings",
+		".gitattributes does not mark *.png as binary or filter=lfs (2 files, e.g. icon.png)",
+		"legacy.txt is committed with CRLF line endings",
+		"build.sh has CRLF line endings in the working tree",
+	} {
+		if !strings.Contains(all, expected) {
+			t.Errorf("Expected finding %q, got:\n%s", expected, all)
+		}
+	}
+
+	if strings.Contains(all, "*.jar") {
+		t.Errorf("LFS-tracked jar files should not be reported:\n%s", all)
+	}
+
+	// Normalization passes; binary marking and line endings fail.
+	expectedScore := 1.0 / 3.0
+	if result.Score != expectedScore {
+		t.Errorf("Expected score %f, got %f", expectedScore, result.Score)
+	}
+}
+
+func TestGitConfigurationAgent_BinaryExtensionsIgnoreCase(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		".gitattributes": "* text=auto\n",
+		"logo.png":       "\x89PNG\x00",
+		"photo.JPG":      "\xff\xd8\xff",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	gitCfg := &cfg.Validation.Agents.GitConfiguration
+	gitCfg.ValidationRules.GitattributesValidation = true
+	gitCfg.GitattributesValidation.BinaryExtensions = []string{".PNG", "Jpg"}
+
+	result, err := NewGitConfigurationAgent().Validate(dir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	reported := map[string]bool{}
+	for _, finding := range result.Findings {
+		for _, extension := range []string{"png", "jpg"} {
+			if strings.Contains(finding.Message, "does not mark *."+extension+" ") {
+				reported[extension] = true
+			}
+		}
+	}
+	if !reported["png"] || !reported["jpg"] {
+		t.Errorf("Expected mixed-case extensions to match, got %+v", result.Findings)
+	}
+}
@@ -0,0 +1,130 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// projectMarkers maps each detectable language to the manifest files that
+// identify it.
+var projectMarkers = map[string][]string{
+	"go":     {"go.mod"},
+	"node":   {"package.json"},
+	"python": {"pyproject.toml", "setup.py", "requirements.txt", "Pipfile"},
+	"rust":   {"Cargo.toml"},
+}
+
+// defaultGitignorePatterns are used for a detected language when the
+// configuration does not list required patterns for it.
+var defaultGitignorePatterns = map[string][]string{
+	"go":     {"*.exe", "*.test", "*.out"},
+	"node":   {"node_modules/"},
+	"python": {"__pycache__/"},
+	"rust":   {"target/"},
+}
+
+type gitignoreRule struct {
+	Pattern string
+	Negated bool
+	Line    int
+}
+
+// parseGitignore reads the rules from a .gitignore file, skipping blank
+// lines and comments.
+func parseGitignore(path string) ([]gitignoreRule, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var rules []gitignoreRule
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := gitignoreRule{Line: lineNumber}
+		if strings.HasPrefix(line, "!") {
+			rule.Negated = true
+			line = line[1:]
+		}
+		rule.Pattern = strings.TrimPrefix(line, `\`)
+		rules = append(rules, rule)
+	}
+
+	return rules, scanner.Err()
+}
+
+// normalizeIgnorePattern reduces equivalent spellings of a pattern to one
+// form: "/bin/", "**/bin" and "bin" all become "bin".
+func normalizeIgnorePattern(pattern string) string {
+	pattern = strings.TrimPrefix(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "**/")
+	return strings.TrimSuffix(pattern, "/")
+}
+
+// missingIgnorePatterns returns the required patterns that have no
+// equivalent, non-negated rule.
+func missingIgnorePatterns(rules []gitignoreRule, required []string) []string {
+	present := map[string]bool{}
+	for _, rule := range rules {
+		if !rule.Negated {
+			present[normalizeIgnorePattern(rule.Pattern)] = true
+		}
+	}
+
+	var missing []string
+	for _, pattern := range required {
+		if !present[normalizeIgnorePattern(pattern)] {
+			missing = append(missing, pattern)
+		}
+	}
+	return missing
+}
+
+// detectLanguages returns the languages whose manifest files are present in
+// targetPath, sorted by name.
+func detectLanguages(targetPath string) []string {
+	var languages []string
+	for language, markers := range projectMarkers {
+		for _, marker := range markers {
+			if _, err := os.Stat(filepath.Join(targetPath, marker)); err == nil {
+				languages = append(languages, language)
+				break
+			}
+		}
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// trackedIgnoredFiles asks git which tracked files match the ignore rules,
+// using git's own matching semantics. It returns false when targetPath is
+// not inside a git work tree.
+func trackedIgnoredFiles(ctx context.Context, targetPath string) ([]string, bool, error) {
+	if !insideWorkTree(ctx, targetPath) {
+		return nil, false, nil
+	}
+
+	output, err := gitOutput(ctx, targetPath, "ls-files", "--cached", "--ignored", "--exclude-standard", "-z")
+	if err != nil {
+		return nil, true, err
+	}
+
+	var files []string
+	for _, file := range strings.Split(string(output), "\x00") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, true, nil
+}
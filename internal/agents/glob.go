@@ -0,0 +1,219 @@
+package agents
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// matchGlob reports whether the slash-separated path matches pattern.
+//
+// Patterns support '*' and '?' (which never cross a '/'), character classes
+// ('[abc]', '[!abc]'), brace alternatives ('{md,rst}') and '**', which matches
+// any number of directories, so 'docs/**/*.md' matches both 'docs/a.md' and
+// 'docs/guide/b.md'.
+func matchGlob(pattern, path string) bool {
+	re, err := compileGlob(pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(path)
+}
+
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^" + globToRegexp(pattern) + "$")
+}
+
+func globToRegexp(pattern string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				switch {
+				case i+1 < len(pattern) && pattern[i+1] == '/':
+					i++
+					sb.WriteString("(?:.*/)?")
+				default:
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '{':
+			end := matchingBrace(pattern, i)
+			if end < 0 {
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			alternatives := splitAlternatives(pattern[i+1 : end])
+			parts := make([]string, 0, len(alternatives))
+			for _, alt := range alternatives {
+				parts = append(parts, globToRegexp(alt))
+			}
+			sb.WriteString("(?:" + strings.Join(parts, "|") + ")")
+			i = end
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				sb.WriteString(regexp.QuoteMeta(string(pattern[i])))
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
+
+func matchingBrace(pattern string, start int) int {
+	depth := 0
+	for i := start; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func splitAlternatives(s string) []string {
+	var parts []string
+	depth, last := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(parts, s[last:])
+}
+
+// listPaths returns every file and directory below root as slash-separated
+// paths relative to root. The .git directory is skipped. The walk stops with
+// ctx's error once ctx is done.
+func listPaths(ctx context.Context, root string) ([]string, error) {
+	var paths []string
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+
+	return paths, err
+}
+
+// globFiles returns the paths below root that match pattern. Patterns without
+// a '/' only match entries in root itself, so the full tree is only walked
+// when a nested pattern asks for it.
+func globFiles(ctx context.Context, root, pattern string) ([]string, error) {
+	re, err := compileGlob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	if strings.Contains(pattern, "/") {
+		candidates, err = listPaths(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		entries, err := os.ReadDir(root)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			candidates = append(candidates, entry.Name())
+		}
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if re.MatchString(candidate) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	return matches, nil
+}
+
+// projectFiles returns the files to inspect below targetPath: the files
+// tracked by git when targetPath is in a work tree, otherwise every regular
+// file outside .git.
+func projectFiles(ctx context.Context, targetPath string) ([]string, error) {
+	if output, err := gitOutput(ctx, targetPath, "ls-files", "--cached", "-z"); err == nil {
+		var files []string
+		for _, file := range strings.Split(string(output), "\x00") {
+			if file == "" {
+				continue
+			}
+			if info, err := os.Lstat(filepath.Join(targetPath, file)); err == nil && info.Mode().IsRegular() {
+				files = append(files, file)
+			}
+		}
+		return files, nil
+	}
+
+	paths, err := listPaths(ctx, targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, path := range paths {
+		if info, err := os.Lstat(filepath.Join(targetPath, path)); err == nil && info.Mode().IsRegular() {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
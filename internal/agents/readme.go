@@ -0,0 +1,318 @@
+package agents
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var readmeFiles = []string{"README.md", "README.rst", "readme.md", "readme.rst"}
+
+var (
+	atxHeadingPattern    = regexp.MustCompile(`^ {0,3}(#{1,6})\s+(.*?)\s*#*\s*$`)
+	setextH1Pattern      = regexp.MustCompile(`^ {0,3}=+\s*$`)
+	setextH2Pattern      = regexp.MustCompile(`^ {0,3}-+\s*$`)
+	htmlHeadingPattern   = regexp.MustCompile(`(?i)<h([1-6])[^>]*>(.*?)</h[1-6]>`)
+	htmlTagPattern       = regexp.MustCompile(`<[^>]+>`)
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\(([^)\s]*)[^)]*\)`)
+	htmlImagePattern     = regexp.MustCompile(`(?i)<img[^>]+src=["']([^"']+)["']`)
+	rstImagePattern      = regexp.MustCompile(`^\.\.\s+(?:\|[^|]+\|\s+)?image::\s*(\S+)`)
+	badgeURLPattern      = regexp.MustCompile(`(?i)shields\.io|badge|travis-ci|codecov|goreportcard|circleci|appveyor|/workflows/`)
+	emptyLinkPattern     = regexp.MustCompile(`\[\]\([^)]*\)`)
+	fencePattern         = regexp.MustCompile("^ {0,3}(```|~~~)")
+	wordPattern          = regexp.MustCompile(`[A-Za-z]{2,}`)
+
+	// Section keywords must start the heading, after any numbering or emoji,
+	// and end on a word boundary, so "Uninstall" is not an installation
+	// section.
+	installationHeadingPattern = regexp.MustCompile(`(?i)^[^\pL]*(?:install(?:ation|ing)?|set ?up|getting started)\b`)
+	usageHeadingPattern        = regexp.MustCompile(`(?i)^[^\pL]*(?:usage|how to use|examples?|quick ?start|getting started)\b`)
+	descriptionHeadingPattern  = regexp.MustCompile(`(?i)^(description|about|overview|introduction|what is)`)
+)
+
+type readmeHeading struct {
+	Level int
+	Title string
+	Line  int
+}
+
+// readmeDocument is the structural view of a README used by the quality
+// checks: its headings, lead description paragraph and badge images.
+type readmeDocument struct {
+	NonBlankLines int
+	Headings      []readmeHeading
+	Description   string
+	Badges        []string
+}
+
+// findReadme returns the first README variant present in targetPath, or ""
+// when there is none.
+func findReadme(targetPath string) string {
+	for _, readmePath := range readmeFiles {
+		if _, err := os.Stat(filepath.Join(targetPath, readmePath)); err == nil {
+			return readmePath
+		}
+	}
+	return ""
+}
+
+// parseReadme parses Markdown, or reStructuredText when isRST is set.
+func parseReadme(content string, isRST bool) readmeDocument {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	doc := readmeDocument{}
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			doc.NonBlankLines++
+		}
+	}
+
+	// blocks holds the prose paragraphs together with the index of the
+	// heading they follow (-1 before the first heading).
+	type block struct {
+		section int
+		text    []string
+	}
+	var blocks []block
+	var paragraph []string
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, block{section: len(doc.Headings) - 1, text: paragraph})
+			paragraph = nil
+		}
+	}
+
+	rstLevels := map[string]int{}
+	inFence := false
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if !isRST && fencePattern.MatchString(line) {
+			flush()
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if trimmed == "" {
+			flush()
+			continue
+		}
+
+		if urls := imageURLs(trimmed, isRST); len(urls) > 0 {
+			for _, url := range urls {
+				if isBadge(trimmed, url) {
+					doc.Badges = append(doc.Badges, url)
+				}
+			}
+			if isImageOnly(trimmed, isRST) {
+				flush()
+				continue
+			}
+		}
+
+		if isRST {
+			if level, title, consumed := rstHeading(lines, i, rstLevels); consumed > 0 {
+				flush()
+				doc.Headings = append(doc.Headings, readmeHeading{Level: level, Title: title, Line: i + 1})
+				i += consumed - 1
+				continue
+			}
+			if strings.HasPrefix(trimmed, "..") {
+				flush()
+				continue
+			}
+		} else {
+			if m := atxHeadingPattern.FindStringSubmatch(line); m != nil {
+				flush()
+				doc.Headings = append(doc.Headings, readmeHeading{Level: len(m[1]), Title: m[2], Line: i + 1})
+				continue
+			}
+			if m := htmlHeadingPattern.FindStringSubmatch(trimmed); m != nil {
+				flush()
+				title := strings.TrimSpace(htmlTagPattern.ReplaceAllString(m[2], ""))
+				doc.Headings = append(doc.Headings, readmeHeading{Level: int(m[1][0] - '0'), Title: title, Line: i + 1})
+				continue
+			}
+			if len(paragraph) == 0 && i+1 < len(lines) {
+				next := lines[i+1]
+				if setextH1Pattern.MatchString(next) || (setextH2Pattern.MatchString(next) && !isListItem(trimmed)) {
+					level := 1
+					if setextH2Pattern.MatchString(next) {
+						level = 2
+					}
+					doc.Headings = append(doc.Headings, readmeHeading{Level: level, Title: trimmed, Line: i + 1})
+					i++
+					continue
+				}
+			}
+			if isThematicBreak(trimmed) {
+				flush()
+				continue
+			}
+			if strings.HasPrefix(trimmed, "<") && htmlTagPattern.ReplaceAllString(trimmed, "") == "" {
+				flush()
+				continue
+			}
+		}
+
+		paragraph = append(paragraph, trimmed)
+	}
+	flush()
+
+	for _, b := range blocks {
+		text := strings.TrimSpace(strings.TrimLeft(strings.Join(b.text, " "), "> "))
+		if !isProse(text) {
+			continue
+		}
+		leadSection := b.section < 0 || (b.section == 0 && doc.Headings[0].Level == 1)
+		describedSection := b.section >= 0 && descriptionHeadingPattern.MatchString(doc.Headings[b.section].Title)
+		if leadSection || describedSection {
+			doc.Description = text
+		}
+		break
+	}
+
+	return doc
+}
+
+// HasSection reports whether any heading title matches pattern.
+func (d readmeDocument) HasSection(pattern *regexp.Regexp) bool {
+	for _, heading := range d.Headings {
+		if pattern.MatchString(heading.Title) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sections reports, for each pattern, whether a heading matches it. Each
+// heading counts for one pattern only, so a "Getting Started" heading cannot
+// stand in for both an installation and a usage section. Patterns choose in
+// order and prefer headings that no later pattern matches.
+func (d readmeDocument) Sections(patterns ...*regexp.Regexp) []bool {
+	found := make([]bool, len(patterns))
+	used := make([]bool, len(d.Headings))
+
+	for i, pattern := range patterns {
+		choice := -1
+		for j, heading := range d.Headings {
+			if used[j] || !pattern.MatchString(heading.Title) {
+				continue
+			}
+			if choice == -1 {
+				choice = j
+			}
+			if !matchesAny(heading.Title, patterns[i+1:]) {
+				choice = j
+				break
+			}
+		}
+		if choice >= 0 {
+			used[choice] = true
+			found[i] = true
+		}
+	}
+	return found
+}
+
+func matchesAny(title string, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(title) {
+			return true
+		}
+	}
+	return false
+}
+
+func rstHeading(lines []string, i int, levels map[string]int) (int, string, int) {
+	title := strings.TrimSpace(lines[i])
+	style := ""
+	consumed := 0
+
+	switch {
+	case isRSTAdornment(title) && i+2 < len(lines) &&
+		strings.TrimSpace(lines[i+2]) == title && strings.TrimSpace(lines[i+1]) != "":
+		style = "over" + title[:1]
+		title = strings.TrimSpace(lines[i+1])
+		consumed = 3
+	case !isRSTAdornment(title) && i+1 < len(lines) &&
+		isRSTAdornment(lines[i+1]) && len(strings.TrimSpace(lines[i+1])) >= len(title):
+		style = strings.TrimSpace(lines[i+1])[:1]
+		consumed = 2
+	default:
+		return 0, "", 0
+	}
+
+	level, ok := levels[style]
+	if !ok {
+		level = len(levels) + 1
+		levels[style] = level
+	}
+	return level, title, consumed
+}
+
+func imageURLs(line string, isRST bool) []string {
+	var urls []string
+	if isRST {
+		if m := rstImagePattern.FindStringSubmatch(line); m != nil {
+			urls = append(urls, m[1])
+		}
+		return urls
+	}
+	for _, m := range markdownImagePattern.FindAllStringSubmatch(line, -1) {
+		urls = append(urls, m[1])
+	}
+	for _, m := range htmlImagePattern.FindAllStringSubmatch(line, -1) {
+		urls = append(urls, m[1])
+	}
+	return urls
+}
+
+// isBadge treats linked images and images served by well-known status
+// services as badges.
+func isBadge(line, url string) bool {
+	return badgeURLPattern.MatchString(url) || strings.Contains(line, "[![")
+}
+
+func isImageOnly(line string, isRST bool) bool {
+	if isRST {
+		return true
+	}
+	rest := markdownImagePattern.ReplaceAllString(line, "")
+	rest = htmlTagPattern.ReplaceAllString(rest, "")
+	rest = emptyLinkPattern.ReplaceAllString(rest, "")
+	return strings.TrimSpace(strings.Trim(rest, "[]() ")) == ""
+}
+
+// isRSTAdornment reports whether line is a section underline or overline: a
+// run of at least three identical punctuation characters.
+func isRSTAdornment(line string) bool {
+	line = strings.TrimSpace(line)
+	if len(line) < 3 || !strings.ContainsRune("=-~^\"'`#*+:._", rune(line[0])) {
+		return false
+	}
+	return strings.Count(line, line[:1]) == len(line)
+}
+
+func isThematicBreak(line string) bool {
+	compact := strings.ReplaceAll(line, " ", "")
+	if len(compact) < 3 || !strings.ContainsRune("-*_", rune(compact[0])) {
+		return false
+	}
+	return strings.Count(compact, compact[:1]) == len(compact)
+}
+
+func isListItem(line string) bool {
+	return strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") || strings.HasPrefix(line, "+ ")
+}
+
+func isProse(text string) bool {
+	return len(wordPattern.FindAllString(text, -1)) >= 3
+}
@@ -0,0 +1,184 @@
+package agents
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+func TestParseReadme_Markdown(t *testing.T) {
+	content := `# My Project
+
+[![Build](https://github.com/org/repo/actions/workflows/ci.yml/badge.svg)](https://github.com/org/repo/actions) [![Coverage](https://img.shields.io/codecov/c/github/org/repo)](https://codecov.io)
+
+My Project validates things so that you do not have to.
+
+Installation
+------------
+
+` + "```bash\n# not a heading\ngo install example.com/project@latest\n```" + `
+
+## Usage
+
+Run it.
+`
+
+	doc := parseReadme(content, false)
+
+	if len(doc.Headings) != 3 {
+		t.Fatalf("Expected 3 headings, got %d: %v", len(doc.Headings), doc.Headings)
+	}
+
+	if doc.Headings[1].Title != "Installation" || doc.Headings[1].Level != 2 {
+		t.Errorf("Expected setext level 2 'Installation' heading, got %+v", doc.Headings[1])
+	}
+
+	if !doc.HasSection(installationHeadingPattern) {
+		t.Error("Expected installation section to be detected")
+	}
+
+	if !doc.HasSection(usageHeadingPattern) {
+		t.Error("Expected usage section to be detected")
+	}
+
+	if doc.Description != "My Project validates things so that you do not have to." {
+		t.Errorf("Unexpected description: %q", doc.Description)
+	}
+
+	if len(doc.Badges) != 2 {
+		t.Errorf("Expected 2 badges, got %d", len(doc.Badges))
+	}
+}
+
+func TestParseReadme_RST(t *testing.T) {
+	content := `==========
+My Project
+==========
+
+.. image:: https://img.shields.io/badge/license-MIT-blue.svg
+
+A reStructuredText project with a proper description.
+
+Installation
+============
+
+Usage
+-----
+`
+
+	doc := parseReadme(content, true)
+
+	if len(doc.Headings) != 3 {
+		t.Fatalf("Expected 3 headings, got %d: %v", len(doc.Headings), doc.Headings)
+	}
+
+	if doc.Headings[0].Title != "My Project" || doc.Headings[0].Level != 1 {
+		t.Errorf("Expected overlined title heading, got %+v", doc.Headings[0])
+	}
+
+	if doc.Headings[2].Level != 3 {
+		t.Errorf("Expected third adornment style to be level 3, got %d", doc.Headings[2].Level)
+	}
+
+	if doc.Description == "" {
+		t.Error("Expected description to be detected")
+	}
+
+	if len(doc.Badges) != 1 {
+		t.Errorf("Expected 1 badge, got %d", len(doc.Badges))
+	}
+}
+
+func TestParseReadme_NoDescription(t *testing.T) {
+	content := "# Project\n\n## Installation\n\nRun the installer to get going quickly.\n"
+
+	doc := parseReadme(content, false)
+
+	if doc.Description != "" {
+		t.Errorf("Expected no lead description, got %q", doc.Description)
+	}
+}
+
+func TestEssentialFilesAgent_ReadmeQuality(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "codebase-test-")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	readme := "# Project\n\nA short description of the project.\n\n## Installation\n\ngo install\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "README.md"), []byte(readme), 0644); err != nil {
+		t.Fatalf("Failed to write README: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.EssentialFiles.RequireContributing = false
+	cfg.Validation.Agents.EssentialFiles.ReadmeQuality = config.ReadmeQualityConfig{
+		MinLines:            3,
+		RequireDescription:  true,
+		RequireInstallation: true,
+		RequireUsage:        true,
+		CheckBadges:         true,
+	}
+
+	result, err := NewEssentialFilesAgent().Validate(tmpDir, cfg)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	// README present, min lines, description, installation pass; usage and
+	// badges fail.
+	expectedScore := 4.0 / 6.0
+	if result.Score != expectedScore {
+		t.Errorf("Expected score %f, got %f", expectedScore, result.Score)
+	}
+
+	if len(result.Findings) != 6 {
+		t.Fatalf("Expected 6 findings, got %d", len(result.Findings))
+	}
+
+	if result.Findings[4].Type != "invalid" || result.Findings[4].Severity != "critical" {
+		t.Errorf("Expected missing usage section to be a critical finding, got %+v", result.Findings[4])
+	}
+
+	if result.Findings[5].Severity != "warning" {
+		t.Errorf("Expected missing badges to be a warning, got %+v", result.Findings[5])
+	}
+}
+
+func TestReadmeDocument_Sections(t *testing.T) {
+	tests := []struct {
+		name         string
+		headings     []string
+		installation bool
+		usage        bool
+	}{
+		{name: "getting started alone", headings: []string{"Getting Started"}, installation: true, usage: false},
+		{name: "getting started and usage", headings: []string{"Getting Started", "Usage"}, installation: true, usage: true},
+		{name: "installation and getting started", headings: []string{"Installation", "Getting Started"}, installation: true, usage: true},
+		{name: "uninstall", headings: []string{"Uninstall"}, installation: false, usage: false},
+		{name: "numbered and emoji headings", headings: []string{"📦 Installation", "2. Examples"}, installation: true, usage: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var doc readmeDocument
+			for _, title := range tt.headings {
+				doc.Headings = append(doc.Headings, readmeHeading{Level: 2, Title: title})
+			}
+
+			found := doc.Sections(installationHeadingPattern, usageHeadingPattern)
+			if found[0] != tt.installation || found[1] != tt.usage {
+				t.Errorf("Expected installation %v and usage %v, got %v and %v", tt.installation, tt.usage, found[0], found[1])
+			}
+		})
+	}
+
+	// Without an installation requirement the heading is free for usage.
+	doc := readmeDocument{Headings: []readmeHeading{{Level: 2, Title: "Getting Started"}}}
+	if found := doc.Sections(usageHeadingPattern); !found[0] {
+		t.Error("Expected Getting Started to count as usage when installation is not required")
+	}
+}
@@ -0,0 +1,125 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// Run validates targetPath with the named agents using at most jobs agents
+// at a time; jobs <= 0 uses one per CPU. Results are returned in the order
+// of names regardless of which agent finishes first.
+//
+// Each agent is limited by its configured timeout and by ctx's deadline. An
+// agent that fails or runs out of time gets a result with status "error"
+// and the other agents carry on. If ctx is cancelled, Run stops and returns
+// the cancellation error.
+func (r *Registry) Run(ctx context.Context, names []string, targetPath string, cfg *config.Config, jobs int) ([]ValidationResult, error) {
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	selected := make([]Agent, len(names))
+	for i, name := range names {
+		agent, exists := r.Get(name)
+		if !exists {
+			return nil, fmt.Errorf("agent '%s' not found", name)
+		}
+		selected[i] = agent
+	}
+
+	results := make([]ValidationResult, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, jobs)
+	for i, agent := range selected {
+		wg.Add(1)
+		go func(i int, agent Agent) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+			}
+
+			results[i], errs[i] = runAgent(ctx, names[i], agent, targetPath, cfg)
+		}(i, agent)
+	}
+	wg.Wait()
+
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return nil, ctx.Err()
+	}
+
+	for i, err := range errs {
+		if err != nil {
+			results[i] = errorResult(names[i], err.Error())
+		}
+	}
+
+	return results, nil
+}
+
+// runAgent runs a single agent under its timeout. Agents that do not
+// implement ContextAgent cannot be stopped, so they are left to finish in
+// the background once their time is up.
+func runAgent(parent context.Context, name string, agent Agent, targetPath string, cfg *config.Config) (ValidationResult, error) {
+	ctx := parent
+	timeout := cfg.AgentTimeout(name)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(parent, timeout)
+		defer cancel()
+	}
+
+	type outcome struct {
+		result ValidationResult
+		err    error
+	}
+	done := make(chan outcome, 1)
+
+	if ctx.Err() == nil {
+		go func() {
+			var o outcome
+			if contextAgent, ok := agent.(ContextAgent); ok {
+				o.result, o.err = contextAgent.ValidateContext(ctx, targetPath, cfg)
+			} else {
+				o.result, o.err = agent.Validate(targetPath, cfg)
+			}
+			done <- o
+		}()
+
+		select {
+		case o := <-done:
+			if ctx.Err() == nil {
+				return o.result, o.err
+			}
+		case <-ctx.Done():
+		}
+	}
+
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ValidationResult{}, ctx.Err()
+	}
+
+	if parent.Err() != nil || timeout <= 0 {
+		return ValidationResult{}, errors.New("agent did not finish before the --timeout deadline")
+	}
+	return ValidationResult{}, fmt.Errorf("agent timed out after %s", timeout)
+}
+
+// errorResult is reported for an agent that could not run to completion.
+func errorResult(name, message string) ValidationResult {
+	return ValidationResult{
+		Agent:    name,
+		Status:   StatusError,
+		Score:    0.0,
+		Error:    message,
+		Findings: []Finding{},
+	}
+}
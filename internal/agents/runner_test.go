@@ -0,0 +1,255 @@
+package agents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// stubAgent returns a fixed result after a delay and records how many stub
+// agents are running at once.
+type stubAgent struct {
+	name    string
+	delay   time.Duration
+	err     error
+	running *int32
+	peak    *int32
+}
+
+func (a *stubAgent) Validate(targetPath string, cfg *config.Config) (ValidationResult, error) {
+	if a.running != nil {
+		current := atomic.AddInt32(a.running, 1)
+		defer atomic.AddInt32(a.running, -1)
+		for {
+			peak := atomic.LoadInt32(a.peak)
+			if current <= peak || atomic.CompareAndSwapInt32(a.peak, peak, current) {
+				break
+			}
+		}
+	}
+
+	time.Sleep(a.delay)
+	return ValidationResult{Agent: a.name, Status: "pass", Score: 1.0, Findings: []Finding{}}, a.err
+}
+
+// blockingAgent waits until its context is done, like a git command stuck
+// on a credential prompt.
+type blockingAgent struct {
+	name string
+}
+
+func (a *blockingAgent) Validate(targetPath string, cfg *config.Config) (ValidationResult, error) {
+	return a.ValidateContext(context.Background(), targetPath, cfg)
+}
+
+func (a *blockingAgent) ValidateContext(ctx context.Context, targetPath string, cfg *config.Config) (ValidationResult, error) {
+	<-ctx.Done()
+	return ValidationResult{}, ctx.Err()
+}
+
+func TestRegistry_Names(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("b", &stubAgent{name: "b"})
+	registry.Register("a", &stubAgent{name: "a"})
+	registry.Register("b", &stubAgent{name: "b2"})
+
+	names := registry.Names()
+	if len(names) != 2 || names[0] != "b" || names[1] != "a" {
+		t.Errorf("Expected registration order [b a], got %v", names)
+	}
+}
+
+func TestRegistry_Run_Order(t *testing.T) {
+	registry := NewRegistry()
+	// Earlier agents take longer, so they finish last.
+	names := []string{"first", "second", "third", "fourth"}
+	for i, name := range names {
+		registry.Register(name, &stubAgent{name: name, delay: time.Duration(len(names)-i) * 5 * time.Millisecond})
+	}
+
+	results, err := registry.Run(context.Background(), names, ".", config.DefaultConfig(), 4)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	for i, name := range names {
+		if results[i].Agent != name {
+			t.Errorf("Expected result %d to be %s, got %s", i, name, results[i].Agent)
+		}
+	}
+}
+
+func TestRegistry_Run_Jobs(t *testing.T) {
+	var running, peak int32
+	registry := NewRegistry()
+	var names []string
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		registry.Register(name, &stubAgent{name: name, delay: 10 * time.Millisecond, running: &running, peak: &peak})
+		names = append(names, name)
+	}
+
+	if _, err := registry.Run(context.Background(), names, ".", config.DefaultConfig(), 2); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 agents at once, got %d", peak)
+	}
+}
+
+func TestRegistry_Run_Error(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("ok", &stubAgent{name: "ok"})
+	registry.Register("slow-broken", &stubAgent{name: "slow-broken", delay: 10 * time.Millisecond, err: errors.New("first")})
+	registry.Register("broken", &stubAgent{name: "broken", err: errors.New("second")})
+
+	results, err := registry.Run(context.Background(), registry.Names(), ".", config.DefaultConfig(), 3)
+	if err != nil {
+		t.Fatalf("Expected agent errors to be reported as results, got error: %v", err)
+	}
+
+	if results[0].Status != "pass" || results[0].Error != "" {
+		t.Errorf("Expected ok to pass despite the other failures, got %+v", results[0])
+	}
+	for i, message := range []string{"first", "second"} {
+		result := results[i+1]
+		if result.Status != StatusError || result.Score != 0 || result.Error != message {
+			t.Errorf("Expected %s to have status error with message %q, got %+v", result.Agent, message, result)
+		}
+		if result.Agent != registry.Names()[i+1] {
+			t.Errorf("Expected result %d to be %s, got %s", i+1, registry.Names()[i+1], result.Agent)
+		}
+	}
+
+	if _, err := registry.Run(context.Background(), []string{"missing"}, ".", config.DefaultConfig(), 1); err == nil {
+		t.Error("Expected an error for an unknown agent")
+	}
+}
+
+func TestRegistry_Run_DeterministicOutput(t *testing.T) {
+	dir := initGitRepo(t, "feat: initial commit", "update things", "fix(cli): handle flags")
+
+	files := map[string]string{
+		"README.md":     "# Project\n",
+		".gitignore":    "*.log\n",
+		".editorconfig": "root = true\n\n[*]\ninsert_final_newline = true\n",
+		"go.mod":        "module example.com/test\n",
+		"notes.txt":     "no final newline",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	runGit(t, dir, "add", ".")
+
+	cfg := config.DefaultConfig()
+	gitCfg := &cfg.Validation.Agents.GitConfiguration
+	gitCfg.ValidationRules = config.ValidationRulesConfig{
+		GitignoreValidation:     true,
+		EditorconfigValidation:  true,
+		GitattributesValidation: true,
+	}
+	gitCfg.EditorconfigValidation.CheckFileCompliance = true
+	gitCfg.GitignoreValidation.CheckLanguageSpecific = true
+	gitCfg.GitignoreValidation.DetectProjectType = true
+
+	registry := NewRegistry()
+	registry.Register("essential-files", NewEssentialFilesAgent())
+	registry.Register("git-configuration", NewGitConfigurationAgent())
+	registry.Register("development-standards", NewDevelopmentStandardsAgent())
+
+	var first []byte
+	for run := 0; run < 10; run++ {
+		results, err := registry.Run(context.Background(), registry.Names(), dir, cfg, 0)
+		if err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+
+		output, err := json.Marshal(results)
+		if err != nil {
+			t.Fatalf("Failed to encode results: %v", err)
+		}
+
+		if run == 0 {
+			first = output
+			continue
+		}
+		if string(output) != string(first) {
+			t.Fatalf("Run %d produced different output:\n%s\nfirst run:\n%s", run, output, first)
+		}
+	}
+}
+
+func TestRegistry_Run_AgentTimeout(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("essential-files", &stubAgent{name: "essential-files"})
+	registry.Register("git-configuration", &blockingAgent{name: "git-configuration"})
+	// Agents that ignore the context are abandoned once their time is up.
+	registry.Register("development-standards", &stubAgent{name: "development-standards", delay: time.Second})
+
+	cfg := config.DefaultConfig()
+	cfg.Validation.Agents.GitConfiguration.Timeout = 20 * time.Millisecond
+	cfg.Validation.Agents.DevelopmentStandards.Timeout = 20 * time.Millisecond
+
+	start := time.Now()
+	results, err := registry.Run(context.Background(), registry.Names(), ".", cfg, 3)
+	if err != nil {
+		t.Fatalf("Expected timeouts to be reported as results, got error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the run to stop at the timeout, took %s", elapsed)
+	}
+
+	if results[0].Status != "pass" {
+		t.Errorf("Expected essential-files to pass, got %+v", results[0])
+	}
+	for _, result := range results[1:] {
+		if result.Status != StatusError || result.Score != 0 {
+			t.Errorf("Expected %s to have status error, got %+v", result.Agent, result)
+		}
+		if result.Error != "agent timed out after 20ms" {
+			t.Errorf("Expected a timeout error for %s, got %q", result.Agent, result.Error)
+		}
+	}
+}
+
+func TestRegistry_Run_Deadline(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("essential-files", &stubAgent{name: "essential-files"})
+	registry.Register("git-configuration", &blockingAgent{name: "git-configuration"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	results, err := registry.Run(ctx, registry.Names(), ".", config.DefaultConfig(), 2)
+	if err != nil {
+		t.Fatalf("Expected the deadline to be reported as results, got error: %v", err)
+	}
+
+	if results[0].Status != "pass" {
+		t.Errorf("Expected essential-files to pass, got %+v", results[0])
+	}
+	if results[1].Status != StatusError || results[1].Error != "agent did not finish before the --timeout deadline" {
+		t.Errorf("Expected git-configuration to miss the deadline, got %+v", results[1])
+	}
+}
+
+func TestRegistry_Run_Cancelled(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("git-configuration", &blockingAgent{name: "git-configuration"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	if _, err := registry.Run(ctx, registry.Names(), ".", config.DefaultConfig(), 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected cancellation error, got %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package agents
+
+// Finding severities, from most to least severe.
+const (
+	SeverityCritical = "critical"
+	SeverityWarning  = "warning"
+	SeverityInfo     = "info"
+)
+
+// Severities lists the finding severities from most to least severe.
+var Severities = []string{SeverityCritical, SeverityWarning, SeverityInfo}
+
+var severityRank = map[string]int{
+	SeverityCritical: 3,
+	SeverityWarning:  2,
+	SeverityInfo:     1,
+}
+
+// ValidSeverity reports whether severity is one of Severities.
+func ValidSeverity(severity string) bool {
+	_, ok := severityRank[severity]
+	return ok
+}
+
+// IsProblem reports whether the finding asks for a fix: a missing or
+// invalid file or setting, as opposed to a check that passed.
+func (f Finding) IsProblem() bool {
+	return f.Type == "missing" || f.Type == "invalid"
+}
+
+// ProblemAtLeast reports whether the finding is a problem at least as
+// severe as threshold.
+func (f Finding) ProblemAtLeast(threshold string) bool {
+	return f.IsProblem() && severityRank[f.Severity] >= severityRank[threshold]
+}
+
+// CountProblems returns how many findings across results are problems at
+// least as severe as threshold.
+func CountProblems(results []ValidationResult, threshold string) int {
+	count := 0
+	for _, result := range results {
+		for _, finding := range result.Findings {
+			if finding.ProblemAtLeast(threshold) {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,45 @@
+package agents
+
+import "testing"
+
+func TestCountProblems(t *testing.T) {
+	results := []ValidationResult{
+		{
+			Agent: "essential-files",
+			Findings: []Finding{
+				{Type: "present", File: "README.md", Severity: "critical"},
+				{Type: "missing", File: "CONTRIBUTING.md", Severity: "critical"},
+				{Type: "missing", File: "SECURITY.md", Severity: "warning"},
+			},
+		},
+		{
+			Agent: "git-configuration",
+			Findings: []Finding{
+				{Type: "present", File: ".gitignore", Severity: "info"},
+				{Type: "invalid", File: ".editorconfig", Severity: "info"},
+				{Type: "invalid", File: "main.go", Severity: "warning"},
+			},
+		},
+	}
+
+	tests := []struct {
+		threshold string
+		expected  int
+	}{
+		{SeverityCritical, 1},
+		{SeverityWarning, 3},
+		{SeverityInfo, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.threshold, func(t *testing.T) {
+			if count := CountProblems(results, tt.threshold); count != tt.expected {
+				t.Errorf("CountProblems(%s) = %d, expected %d", tt.threshold, count, tt.expected)
+			}
+		})
+	}
+
+	if ValidSeverity("error") {
+		t.Error("Expected 'error' not to be a finding severity")
+	}
+}
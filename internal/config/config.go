@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -13,8 +14,9 @@ type Config struct {
 }
 
 type ValidationConfig struct {
-	Agents AgentsConfig `yaml:"agents"`
-	Output OutputConfig `yaml:"output"`
+	Agents  AgentsConfig  `yaml:"agents"`
+	Output  OutputConfig  `yaml:"output"`
+	Scoring ScoringConfig `yaml:"scoring"`
 }
 
 type AgentsConfig struct {
@@ -24,23 +26,140 @@ type AgentsConfig struct {
 }
 
 type EssentialFilesConfig struct {
-	Enabled             bool `yaml:"enabled"`
-	RequireReadme       bool `yaml:"require_readme"`
-	RequireContributing bool `yaml:"require_contributing"`
+	Enabled              bool                   `yaml:"enabled"`
+	Timeout              time.Duration          `yaml:"timeout"`
+	RequireReadme        bool                   `yaml:"require_readme"`
+	RequireContributing  bool                   `yaml:"require_contributing"`
+	RequireDocsDirectory bool                   `yaml:"require_docs_directory"`
+	DocsDirectory        string                 `yaml:"docs_directory"`
+	DocsRequirements     DocsRequirementsConfig `yaml:"docs_requirements"`
+	ReadmeQuality        ReadmeQualityConfig    `yaml:"readme_quality"`
+	CustomFiles          []CustomFileConfig     `yaml:"custom_files"`
+}
+
+// DocsRequirementsConfig describes what the documentation directory must
+// contain. A zero MinDocFiles disables the file count check.
+type DocsRequirementsConfig struct {
+	RequireUsageGuide bool `yaml:"require_usage_guide"`
+	RequireExamples   bool `yaml:"require_examples"`
+	MinDocFiles       int  `yaml:"min_doc_files"`
+}
+
+// ReadmeQualityConfig enables content checks on the README. A zero MinLines
+// disables the length check.
+type ReadmeQualityConfig struct {
+	MinLines            int  `yaml:"min_lines"`
+	RequireDescription  bool `yaml:"require_description"`
+	RequireInstallation bool `yaml:"require_installation"`
+	RequireUsage        bool `yaml:"require_usage"`
+	CheckBadges         bool `yaml:"check_badges"`
+}
+
+// CustomFileConfig describes an additional file requirement matched by a glob
+// pattern relative to the validated path.
+type CustomFileConfig struct {
+	Pattern     string `yaml:"pattern"`
+	Required    bool   `yaml:"required"`
+	Description string `yaml:"description"`
+}
+
+// UnmarshalYAML applies the schema default of required: true to entries that
+// omit the key.
+func (c *CustomFileConfig) UnmarshalYAML(value *yaml.Node) error {
+	type plain CustomFileConfig
+	raw := plain{Required: true}
+	if err := value.Decode(&raw); err != nil {
+		return err
+	}
+	*c = CustomFileConfig(raw)
+	return nil
 }
 
 type GitConfigurationConfig struct {
-	Enabled              bool `yaml:"enabled"`
-	RequireGitignore     bool `yaml:"require_gitignore"`
-	RequireGitattributes bool `yaml:"require_gitattributes"`
-	RequireEditorconfig  bool `yaml:"require_editorconfig"`
+	Enabled                 bool                          `yaml:"enabled"`
+	Timeout                 time.Duration                 `yaml:"timeout"`
+	RequireGitignore        bool                          `yaml:"require_gitignore"`
+	RequireGitattributes    bool                          `yaml:"require_gitattributes"`
+	RequireEditorconfig     bool                          `yaml:"require_editorconfig"`
+	ValidationRules         ValidationRulesConfig         `yaml:"validation_rules"`
+	GitignoreValidation     GitignoreValidationConfig     `yaml:"gitignore_validation"`
+	EditorconfigValidation  EditorconfigValidationConfig  `yaml:"editorconfig_validation"`
+	GitattributesValidation GitattributesValidationConfig `yaml:"gitattributes_validation"`
+}
+
+// ValidationRulesConfig switches on content validation for each Git file.
+type ValidationRulesConfig struct {
+	GitignoreValidation     bool `yaml:"gitignore_validation"`
+	EditorconfigValidation  bool `yaml:"editorconfig_validation"`
+	GitattributesValidation bool `yaml:"gitattributes_validation"`
+}
+
+// EditorconfigValidationConfig extends .editorconfig validation. With
+// CheckFileCompliance set, project files are checked against the properties
+// that apply to them.
+type EditorconfigValidationConfig struct {
+	CheckFileCompliance bool `yaml:"check_file_compliance"`
+}
+
+// GitattributesValidationConfig lists the file extensions that must be
+// marked binary (or -text) or stored with Git LFS when found in the project.
+// Extensions match case-insensitively, with or without the leading dot.
+type GitattributesValidationConfig struct {
+	BinaryExtensions []string `yaml:"binary_extensions"`
+}
+
+// GitignoreValidationConfig lists the ignore patterns required per language.
+// With DetectProjectType set, only languages detected from manifest files
+// are checked; languages without configured patterns use built-in defaults.
+type GitignoreValidationConfig struct {
+	CheckLanguageSpecific bool                `yaml:"check_language_specific"`
+	DetectProjectType     bool                `yaml:"detect_project_type"`
+	RequiredPatterns      map[string][]string `yaml:"required_patterns"`
 }
 
 type DevelopmentStandardsConfig struct {
-	Enabled                    bool `yaml:"enabled"`
-	CheckCommitHistory         bool `yaml:"check_commit_history"`
-	CommitHistoryDepth         int  `yaml:"commit_history_depth"`
-	RequireConventionalCommits bool `yaml:"require_conventional_commits"`
+	Enabled                    bool                      `yaml:"enabled"`
+	Timeout                    time.Duration             `yaml:"timeout"`
+	CheckCommitHistory         bool                      `yaml:"check_commit_history"`
+	CommitHistoryDepth         int                       `yaml:"commit_history_depth"`
+	RequireConventionalCommits bool                      `yaml:"require_conventional_commits"`
+	ValidationThreshold        float64                   `yaml:"validation_threshold"`
+	BranchValidation           bool                      `yaml:"branch_validation"`
+	ConventionalCommits        ConventionalCommitsConfig `yaml:"conventional_commits"`
+	BranchNaming               BranchNamingConfig        `yaml:"branch_naming"`
+	CommitAnalysis             CommitAnalysisConfig      `yaml:"commit_analysis"`
+}
+
+// BranchNamingConfig lists the regular expressions a branch name may match.
+// Protected branches are long-lived names accepted as-is.
+type BranchNamingConfig struct {
+	Patterns          []string `yaml:"patterns"`
+	ProtectedBranches []string `yaml:"protected_branches"`
+	CaseSensitivity   bool     `yaml:"case_sensitivity"`
+	MinLength         int      `yaml:"min_length"`
+	MaxLength         int      `yaml:"max_length"`
+}
+
+// ConventionalCommitsConfig restricts the parts of a conventional commit
+// header. An empty Scopes list allows any scope.
+type ConventionalCommitsConfig struct {
+	AllowedTypes                []string `yaml:"allowed_types"`
+	RequireScope                bool     `yaml:"require_scope"`
+	Scopes                      []string `yaml:"scopes"`
+	RequireBreakingChangeFooter bool     `yaml:"require_breaking_change_footer"`
+}
+
+// CommitAnalysisConfig holds checks applied to every analysed commit. The
+// message lengths apply to the header line; zero disables a limit. Ignored
+// commits are excluded from the compliance ratio. CheckBreakingChanges
+// requires the '!' marker and the BREAKING CHANGE footer to appear together.
+type CommitAnalysisConfig struct {
+	MinMessageLength     int  `yaml:"min_message_length"`
+	MaxMessageLength     int  `yaml:"max_message_length"`
+	CheckBreakingChanges bool `yaml:"check_breaking_changes"`
+	IgnoreMergeCommits   bool `yaml:"ignore_merge_commits"`
+	IgnoreFixupCommits   bool `yaml:"ignore_fixup_commits"`
+	IgnoreRevertCommits  bool `yaml:"ignore_revert_commits"`
 }
 
 type OutputConfig struct {
@@ -56,47 +175,169 @@ func DefaultConfig() *Config {
 					Enabled:             true,
 					RequireReadme:       true,
 					RequireContributing: true,
+					DocsDirectory:       "docs",
 				},
 				GitConfiguration: GitConfigurationConfig{
 					Enabled:              true,
 					RequireGitignore:     true,
 					RequireGitattributes: false,
 					RequireEditorconfig:  true,
+					GitattributesValidation: GitattributesValidationConfig{
+						BinaryExtensions: []string{
+							"png", "jpg", "jpeg", "gif", "ico", "pdf",
+							"zip", "gz", "tar", "jar", "war",
+							"exe", "dll", "so", "dylib",
+							"ttf", "woff", "woff2",
+						},
+					},
 				},
 				DevelopmentStandards: DevelopmentStandardsConfig{
 					Enabled:                    true,
 					CheckCommitHistory:         true,
 					CommitHistoryDepth:         10,
 					RequireConventionalCommits: true,
+					ValidationThreshold:        0.8,
+					BranchValidation:           true,
+					ConventionalCommits: ConventionalCommitsConfig{
+						AllowedTypes: []string{"feat", "fix", "docs", "style", "refactor", "test", "chore", "perf", "ci", "build", "revert"},
+					},
+					BranchNaming: BranchNamingConfig{
+						Patterns: []string{
+							"^(feature|feat)/.+",
+							"^(fix|bugfix)/.+",
+							"^(hotfix|patch)/.+",
+							"^(release|rel)/.+",
+							"^(docs|documentation)/.+",
+							"^(chore|task)/.+",
+						},
+						ProtectedBranches: []string{"main", "master", "develop", "development"},
+						MinLength:         3,
+						MaxLength:         100,
+					},
+					CommitAnalysis: CommitAnalysisConfig{
+						IgnoreMergeCommits:  true,
+						IgnoreFixupCommits:  true,
+						IgnoreRevertCommits: true,
+					},
 				},
 			},
 			Output: OutputConfig{
 				Format:  "table",
 				Verbose: false,
 			},
+			Scoring: ScoringConfig{
+				PassThreshold:    0.8,
+				WarningThreshold: 0.6,
+			},
 		},
 	}
 }
 
+// configFileName is the configuration file init-config writes.
+const configFileName = ".codebase-validation.yml"
+
+// ConfigFileNames lists the configuration files looked for in a directory, in
+// order of preference. JSON files are read as YAML, of which JSON is a subset.
+var ConfigFileNames = []string{
+	configFileName,
+	".codebase-validation.yaml",
+	".codebase-validation.json",
+	".codebase-validation.toml",
+	".config/codebase-validation.yml",
+	".config/codebase-validation.yaml",
+	".config/codebase-validation.json",
+	".config/codebase-validation.toml",
+}
+
 func Load(targetPath string) (*Config, error) {
-	cfg := DefaultConfig()
+	cfg, _, err := LoadWithProvenance(targetPath)
+	return cfg, err
+}
 
-	configPath := filepath.Join(targetPath, ".codebase-validation.yml")
+// LoadWithProvenance is Load that also reports where each setting came from.
+func LoadWithProvenance(targetPath string) (*Config, Provenance, error) {
+	configPath, err := Discover(targetPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if configPath == "" {
+		return DefaultConfig(), Provenance{}, nil
+	}
 
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return cfg, nil
+	return LoadFileWithProvenance(configPath)
+}
+
+// LoadFileWithProvenance loads the configuration in file, in the format its
+// extension names, and reports where each setting came from.
+func LoadFileWithProvenance(file string) (*Config, Provenance, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	data, err := os.ReadFile(configPath)
+	return parseWithProvenance(file, data)
+}
+
+// Discover returns the configuration file that applies to targetPath: the
+// first of ConfigFileNames in targetPath or, when it lies in a Git
+// repository, in the nearest directory above it up to the repository root.
+// Outside a repository only targetPath itself is searched. It returns "" when
+// there is no configuration file.
+func Discover(targetPath string) (string, error) {
+	dir, err := filepath.Abs(targetPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return "", fmt.Errorf("failed to resolve %s: %w", targetPath, err)
 	}
 
-	if err := yaml.Unmarshal(data, cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	dirs := []string{dir}
+	for current := dir; !exists(filepath.Join(current, ".git")); {
+		parent := filepath.Dir(current)
+		if parent == current {
+			// Not in a repository.
+			dirs = dirs[:1]
+			break
+		}
+		current = parent
+		dirs = append(dirs, current)
 	}
 
-	return cfg, nil
+	for _, dir := range dirs {
+		if file := findConfigFile(dir); file != "" {
+			return file, nil
+		}
+	}
+	return "", nil
+}
+
+// findConfigFile returns the first of ConfigFileNames present in dir, or "".
+func findConfigFile(dir string) string {
+	for _, name := range ConfigFileNames {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if info, err := os.Stat(file); err == nil && !info.IsDir() {
+			return file
+		}
+	}
+	return ""
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// AgentTimeout returns the configured time limit for an agent, or zero
+// when the agent may run without a limit.
+func (c *Config) AgentTimeout(agentName string) time.Duration {
+	switch agentName {
+	case "essential-files":
+		return c.Validation.Agents.EssentialFiles.Timeout
+	case "git-configuration":
+		return c.Validation.Agents.GitConfiguration.Timeout
+	case "development-standards":
+		return c.Validation.Agents.DevelopmentStandards.Timeout
+	default:
+		return 0
+	}
 }
 
 func (c *Config) IsAgentEnabled(agentName string) bool {
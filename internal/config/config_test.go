@@ -1,8 +1,13 @@
 package config
 
 import (
+	"errors"
+	"math"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -48,6 +53,10 @@ func TestDefaultConfig(t *testing.T) {
 		t.Error("Commit history check should be enabled by default")
 	}
 
+	if analysis := cfg.Validation.Agents.DevelopmentStandards.CommitAnalysis; analysis.MinMessageLength != 0 || analysis.MaxMessageLength != 0 {
+		t.Errorf("Commit message length limits should be disabled by default, got %d-%d", analysis.MinMessageLength, analysis.MaxMessageLength)
+	}
+
 	if cfg.Validation.Agents.DevelopmentStandards.CommitHistoryDepth != 10 {
 		t.Errorf("Expected commit history depth 10, got %d", cfg.Validation.Agents.DevelopmentStandards.CommitHistoryDepth)
 	}
@@ -101,3 +110,421 @@ func TestIsAgentEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_Scoring(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "config-test-")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	content := "validation:\n  scoring:\n    pass_threshold: 0.5\n    warning_threshold: 0.3\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".codebase-validation.yml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Validation.Scoring.PassThreshold != 0.5 {
+		t.Errorf("Expected pass threshold 0.5, got %f", cfg.Validation.Scoring.PassThreshold)
+	}
+
+	if cfg.Validation.Scoring.WarningThreshold != 0.3 {
+		t.Errorf("Expected warning threshold 0.3, got %f", cfg.Validation.Scoring.WarningThreshold)
+	}
+}
+
+func TestLoad_ScoringOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "both thresholds set",
+			content:  "validation:\n  scoring:\n    pass_threshold: 0.3\n    warning_threshold: 0.6\n",
+			expected: ".codebase-validation.yml:4:5: validation.scoring: warning_threshold 0.6 is above pass_threshold 0.3",
+		},
+		{
+			name:     "pass threshold below the default warning threshold",
+			content:  "validation:\n  scoring:\n    pass_threshold: 0.5\n",
+			expected: ".codebase-validation.yml:3:5: validation.scoring: warning_threshold 0.6 is above pass_threshold 0.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, ".codebase-validation.yml"), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			_, err := Load(dir)
+			var configErr *ConfigError
+			if !errors.As(err, &configErr) || !strings.HasSuffix(err.Error(), tt.expected) {
+				t.Errorf("Expected a configuration error ending in %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestScoringConfig_Summarize(t *testing.T) {
+	tests := []struct {
+		name           string
+		scoring        ScoringConfig
+		scores         []float64
+		expectedScore  float64
+		expectedStatus string
+		expectedPassed bool
+	}{
+		{
+			name:           "above pass threshold",
+			scoring:        ScoringConfig{PassThreshold: 0.8, WarningThreshold: 0.6},
+			scores:         []float64{1.0, 0.8, 0.9},
+			expectedScore:  0.9,
+			expectedStatus: StatusPass,
+			expectedPassed: true,
+		},
+		{
+			name:           "between thresholds",
+			scoring:        ScoringConfig{PassThreshold: 0.8, WarningThreshold: 0.6},
+			scores:         []float64{1.0, 0.5},
+			expectedScore:  0.75,
+			expectedStatus: StatusWarning,
+			expectedPassed: false,
+		},
+		{
+			name:           "below warning threshold",
+			scoring:        ScoringConfig{PassThreshold: 0.8, WarningThreshold: 0.6},
+			scores:         []float64{0.5, 0.5},
+			expectedScore:  0.5,
+			expectedStatus: StatusFail,
+			expectedPassed: false,
+		},
+		{
+			name:           "beginner thresholds pass the same scores",
+			scoring:        ScoringConfig{PassThreshold: 0.5, WarningThreshold: 0.3},
+			scores:         []float64{0.5, 0.5},
+			expectedScore:  0.5,
+			expectedStatus: StatusPass,
+			expectedPassed: true,
+		},
+		{
+			name:           "no agents",
+			scoring:        ScoringConfig{PassThreshold: 0.8, WarningThreshold: 0.6},
+			scores:         nil,
+			expectedScore:  1.0,
+			expectedStatus: StatusPass,
+			expectedPassed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := tt.scoring.Summarize(tt.scores)
+
+			if math.Abs(summary.Score-tt.expectedScore) > 1e-9 {
+				t.Errorf("Expected score %f, got %f", tt.expectedScore, summary.Score)
+			}
+
+			if summary.Status != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s", tt.expectedStatus, summary.Status)
+			}
+
+			if summary.Passed != tt.expectedPassed {
+				t.Errorf("Expected passed %v, got %v", tt.expectedPassed, summary.Passed)
+			}
+		})
+	}
+}
+
+func TestLoad_CustomFilesDefaultRequired(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "config-test-")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	content := `validation:
+  agents:
+    essential-files:
+      custom_files:
+        - pattern: "LICENSE*"
+        - pattern: "SECURITY*"
+          required: false
+          description: "Security policy"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, ".codebase-validation.yml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	customFiles := cfg.Validation.Agents.EssentialFiles.CustomFiles
+	if len(customFiles) != 2 {
+		t.Fatalf("Expected 2 custom files, got %d", len(customFiles))
+	}
+
+	if !customFiles[0].Required {
+		t.Error("Custom file without 'required' should default to required")
+	}
+
+	if customFiles[1].Required {
+		t.Error("Custom file with 'required: false' should not be required")
+	}
+
+	if customFiles[1].Description != "Security policy" {
+		t.Errorf("Expected description 'Security policy', got %q", customFiles[1].Description)
+	}
+}
+
+func TestLoad_ConventionalCommits(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "config-test-")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	content := `validation:
+  agents:
+    development-standards:
+      validation_threshold: 0.9
+      conventional_commits:
+        allowed_types: ["feat", "Fix"]
+        require_scope: true
+      commit_analysis:
+        max_message_length: 100
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, ".codebase-validation.yml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	devCfg := cfg.Validation.Agents.DevelopmentStandards
+	if devCfg.ValidationThreshold != 0.9 {
+		t.Errorf("Expected validation threshold 0.9, got %f", devCfg.ValidationThreshold)
+	}
+
+	if len(devCfg.ConventionalCommits.AllowedTypes) != 2 {
+		t.Errorf("Expected allowed types to be replaced, got %v", devCfg.ConventionalCommits.AllowedTypes)
+	}
+
+	if !devCfg.ConventionalCommits.RequireScope {
+		t.Error("Expected require_scope to be true")
+	}
+
+	if devCfg.CommitAnalysis.MaxMessageLength != 100 || devCfg.CommitAnalysis.MinMessageLength != 0 {
+		t.Errorf("Expected message lengths 0-100, got %d-%d", devCfg.CommitAnalysis.MinMessageLength, devCfg.CommitAnalysis.MaxMessageLength)
+	}
+}
+
+func TestLoad_AgentTimeout(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "config-test-")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	content := "validation:\n  agents:\n    development-standards:\n      timeout: 1m30s\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".codebase-validation.yml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if timeout := cfg.AgentTimeout("development-standards"); timeout != 90*time.Second {
+		t.Errorf("Expected development-standards timeout 1m30s, got %s", timeout)
+	}
+
+	if timeout := cfg.AgentTimeout("git-configuration"); timeout != 0 {
+		t.Errorf("Expected no git-configuration timeout by default, got %s", timeout)
+	}
+}
+
+func TestLoad_Strict(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "unknown key",
+			content:  "validation:\n  agents:\n    essential-files:\n      require_readmee: true\n",
+			expected: []string{".codebase-validation.yml:4:7: validation.agents.essential-files: Additional property require_readmee is not allowed"},
+		},
+		{
+			name:    "unknown key in list entry and wrong type",
+			content: "validation:\n  agents:\n    essential-files:\n      custom_files:\n        - pattern: LICENSE\n          requird: false\n  scoring:\n    pass_threshold: high\n",
+			expected: []string{
+				".codebase-validation.yml:6:11: validation.agents.essential-files.custom_files.0: Additional property requird is not allowed",
+				".codebase-validation.yml:8:5: validation.scoring.pass_threshold: Invalid type. Expected: number, given: string",
+			},
+		},
+		{
+			name:     "syntax error",
+			content:  "validation:\n  agents: [\n",
+			expected: []string{".codebase-validation.yml:2: did not find expected node content"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, ".codebase-validation.yml"), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			_, err := Load(tmpDir)
+			configErr, ok := err.(*ConfigError)
+			if !ok {
+				t.Fatalf("Expected a *ConfigError, got %v", err)
+			}
+
+			messages := configErr.Messages()
+			if len(messages) != len(tt.expected) {
+				t.Fatalf("Expected %d problems, got %d: %v", len(tt.expected), len(messages), messages)
+			}
+			for i, expected := range tt.expected {
+				if !strings.HasSuffix(messages[i], expected) {
+					t.Errorf("Expected problem %q, got %q", expected, messages[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLoad_EmptyFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, ".codebase-validation.yml"), []byte("# nothing configured yet\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !cfg.Validation.Agents.EssentialFiles.Enabled {
+		t.Error("Expected defaults for a config file without settings")
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	repo := t.TempDir()
+	if err := os.Mkdir(filepath.Join(repo, ".git"), 0755); err != nil {
+		t.Fatalf("Failed to create .git: %v", err)
+	}
+	deep := filepath.Join(repo, "services", "api")
+	if err := os.MkdirAll(deep, 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	file, err := Discover(deep)
+	if err != nil || file != "" {
+		t.Fatalf("Expected no configuration file, got %q (%v)", file, err)
+	}
+
+	rootConfig := filepath.Join(repo, ".config", "codebase-validation.toml")
+	writeConfig(t, rootConfig, "[validation.output]\nverbose = true\n")
+	if file, _ := Discover(deep); file != rootConfig {
+		t.Errorf("Expected discovery to reach the repository root, got %q", file)
+	}
+
+	// The nearest directory wins, and within it the preferred name.
+	nearer := filepath.Join(repo, "services", ".codebase-validation.yaml")
+	writeConfig(t, nearer, "validation: {}\n")
+	writeConfig(t, filepath.Join(repo, "services", ".codebase-validation.json"), "{}\n")
+	if file, _ := Discover(deep); file != nearer {
+		t.Errorf("Expected %q, got %q", nearer, file)
+	}
+}
+
+func TestDiscover_OutsideRepository(t *testing.T) {
+	parent := t.TempDir()
+	writeConfig(t, filepath.Join(parent, configFileName), "validation: {}\n")
+	target := filepath.Join(parent, "project")
+	if err := os.Mkdir(target, 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	if file, _ := Discover(target); file != "" {
+		t.Errorf("Expected no discovery above a target outside a repository, got %q", file)
+	}
+}
+
+func TestLoad_Formats(t *testing.T) {
+	tests := map[string]string{
+		".codebase-validation.json": `{
+	"validation": {
+		"agents": {"development-standards": {"commit_history_depth": 42, "timeout": "30s"}},
+		"scoring": {"pass_threshold": 0.9}
+	}
+}
+`,
+		".codebase-validation.toml": `[validation.agents.development-standards]
+commit_history_depth = 42
+timeout = "30s"
+
+[validation.scoring]
+pass_threshold = 0.9
+`,
+		".config/codebase-validation.yml": `validation:
+  agents:
+    development-standards:
+      commit_history_depth: 42
+      timeout: 30s
+  scoring:
+    pass_threshold: 0.9
+`,
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeConfig(t, filepath.Join(dir, filepath.FromSlash(name)), content)
+
+			cfg, err := Load(dir)
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			standards := cfg.Validation.Agents.DevelopmentStandards
+			if standards.CommitHistoryDepth != 42 || standards.Timeout != 30*time.Second {
+				t.Errorf("Expected depth 42 and a 30s timeout, got %d and %s", standards.CommitHistoryDepth, standards.Timeout)
+			}
+			if cfg.Validation.Scoring.PassThreshold != 0.9 || cfg.Validation.Scoring.WarningThreshold != 0.6 {
+				t.Errorf("Expected scoring merged over the defaults, got %+v", cfg.Validation.Scoring)
+			}
+		})
+	}
+}
+
+func TestParse_TOMLErrors(t *testing.T) {
+	tests := map[string]string{
+		"[validation.scoring]\npass_threshold = = 1\n":      "config.toml:2:",
+		"[validation.scoring]\npass_threshold = \"high\"\n": "config.toml: validation.scoring.pass_threshold: Invalid type",
+		"[validation]\nunknown = true\n":                    "config.toml: validation: Additional property unknown is not allowed",
+	}
+
+	for content, expected := range tests {
+		_, err := Parse("config.toml", []byte(content))
+		if err == nil {
+			t.Errorf("Expected an error for %q", content)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("Expected error starting with %q, got %q", expected, err.Error())
+		}
+	}
+}
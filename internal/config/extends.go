@@ -0,0 +1,167 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// extendsKey is the top-level key that lists the configurations a file
+// builds on.
+const extendsKey = "extends"
+
+// resolveDocument parses a configuration document and merges it over the
+// documents it extends, in order. file names the document in errors and
+// anchors its relative extends paths; key identifies it for cycle
+// detection, and chain holds the keys of the documents that led here. The
+// file each node came from is noted in origins. It returns nil for an empty
+// document.
+func resolveDocument(file, key string, data []byte, chain []string, origins map[*yaml.Node]string) (*yaml.Node, error) {
+	root, err := ParseDocument(file, data)
+	if err != nil {
+		return nil, err
+	}
+	if root.Kind == 0 {
+		// An empty file or one holding only comments.
+		return nil, nil
+	}
+
+	if err := validateSchema(file, root); err != nil {
+		return nil, err
+	}
+	recordOrigins(root.Content[0], file, origins)
+
+	body, references := splitExtends(root.Content[0])
+	chain = append(chain, key)
+
+	var merged *yaml.Node
+	for _, reference := range references {
+		parentFile, parentKey, parentData, err := readExtended(file, reference.Value)
+		if err != nil {
+			return nil, configProblem(file, reference, err.Error())
+		}
+
+		for i, seen := range chain {
+			if seen == parentKey {
+				cycle := append(append([]string{}, chain[i:]...), parentKey)
+				return nil, configProblem(file, reference, "cycle detected: "+strings.Join(cycle, " -> "))
+			}
+		}
+
+		parent, err := resolveDocument(parentFile, parentKey, parentData, chain, origins)
+		if err != nil {
+			return nil, err
+		}
+		merged = mergeNodes(merged, parent)
+	}
+
+	return mergeNodes(merged, body), nil
+}
+
+// splitExtends returns a copy of the document's top-level mapping without
+// the extends key, and the extends entries in order. The schema has already
+// checked that extends is a string or a list of strings.
+func splitExtends(document *yaml.Node) (*yaml.Node, []*yaml.Node) {
+	if document.Kind != yaml.MappingNode {
+		return document, nil
+	}
+
+	body := *document
+	body.Content = nil
+	var references []*yaml.Node
+	for i := 0; i+1 < len(document.Content); i += 2 {
+		key, value := document.Content[i], document.Content[i+1]
+		if key.Value != extendsKey {
+			body.Content = append(body.Content, key, value)
+			continue
+		}
+
+		if value.Kind == yaml.SequenceNode {
+			references = append(references, value.Content...)
+		} else {
+			references = append(references, value)
+		}
+	}
+	return &body, references
+}
+
+// readExtended loads the document an extends entry refers to: a built-in
+// preset ("preset:strict"), or a file relative to the extending file. A
+// directory stands for the configuration file inside it, found as Discover
+// would, so ".." picks up the parent directory's configuration.
+func readExtended(file, reference string) (string, string, []byte, error) {
+	if name, ok := strings.CutPrefix(reference, presetPrefix); ok {
+		data, err := Preset(name)
+		return reference, reference, data, err
+	}
+
+	path := reference
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(file), path)
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		if found := findConfigFile(path); found != "" {
+			path = found
+		} else {
+			path = filepath.Join(path, configFileName)
+		}
+	}
+
+	key, err := filepath.Abs(path)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("cannot read extended configuration: %w", err)
+	}
+	return path, key, data, nil
+}
+
+// mergeNodes deep-merges override into base without modifying either.
+// Mappings are merged key by key; any other value in override replaces the
+// one in base, so lists are replaced rather than appended to.
+func mergeNodes(base, override *yaml.Node) *yaml.Node {
+	if base == nil {
+		return override
+	}
+	if override == nil {
+		return base
+	}
+	if base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		return override
+	}
+
+	merged := *base
+	merged.Content = append([]*yaml.Node{}, base.Content...)
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+
+		found := false
+		for j := 0; j+1 < len(merged.Content); j += 2 {
+			if merged.Content[j].Value == key.Value {
+				merged.Content[j+1] = mergeNodes(merged.Content[j+1], value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged.Content = append(merged.Content, key, value)
+		}
+	}
+	return &merged
+}
+
+// configProblem reports a single problem at node's position in file.
+func configProblem(file string, node *yaml.Node, message string) error {
+	return &ConfigError{File: file, Problems: []Problem{{
+		Line:    node.Line,
+		Column:  node.Column,
+		Field:   extendsKey,
+		Message: message,
+	}}}
+}
@@ -0,0 +1,162 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeConfig(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+}
+
+func TestPresets(t *testing.T) {
+	for _, name := range PresetNames {
+		t.Run(name, func(t *testing.T) {
+			data, err := Preset(name)
+			if err != nil {
+				t.Fatalf("Preset failed: %v", err)
+			}
+			if _, err := Parse(name+".yml", data); err != nil {
+				t.Errorf("Expected preset to be a valid configuration: %v", err)
+			}
+		})
+	}
+
+	data, err := Preset("strict")
+	if err != nil {
+		t.Fatalf("Preset failed: %v", err)
+	}
+	cfg, err := Parse("strict.yml", data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if analysis := cfg.Validation.Agents.DevelopmentStandards.CommitAnalysis; analysis.MinMessageLength != 15 || analysis.MaxMessageLength != 72 {
+		t.Errorf("Expected the strict preset to limit headers to 15-72 characters, got %d-%d", analysis.MinMessageLength, analysis.MaxMessageLength)
+	}
+
+	if _, err := Preset("unknown"); err == nil {
+		t.Error("Expected an error for an unknown preset")
+	}
+}
+
+func TestLoad_Extends(t *testing.T) {
+	root := t.TempDir()
+	writeConfig(t, filepath.Join(root, "shared", "base.yml"), `validation:
+  agents:
+    development-standards:
+      commit_history_depth: 25
+      conventional_commits:
+        allowed_types: ["feat", "fix", "docs"]
+`)
+	writeConfig(t, filepath.Join(root, configFileName), `extends: preset:strict
+validation:
+  scoring:
+    pass_threshold: 0.9
+`)
+	repo := filepath.Join(root, "services", "api")
+	writeConfig(t, filepath.Join(repo, configFileName), `extends:
+  - ../..
+  - ../../shared/base.yml
+validation:
+  agents:
+    development-standards:
+      conventional_commits:
+        allowed_types: ["feat"]
+`)
+
+	cfg, err := Load(repo)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	devCfg := cfg.Validation.Agents.DevelopmentStandards
+	if !cfg.Validation.Agents.GitConfiguration.RequireGitattributes {
+		t.Error("Expected require_gitattributes from the strict preset")
+	}
+	if cfg.Validation.Scoring.PassThreshold != 0.9 || cfg.Validation.Scoring.WarningThreshold != 0.85 {
+		t.Errorf("Expected pass threshold from the parent and warning threshold from the preset, got %+v", cfg.Validation.Scoring)
+	}
+	if devCfg.CommitHistoryDepth != 25 {
+		t.Errorf("Expected commit history depth 25 from the later extends entry, got %d", devCfg.CommitHistoryDepth)
+	}
+	if !devCfg.ConventionalCommits.RequireScope {
+		t.Error("Expected require_scope from the strict preset to survive the deep merge")
+	}
+	if len(devCfg.ConventionalCommits.AllowedTypes) != 1 || devCfg.ConventionalCommits.AllowedTypes[0] != "feat" {
+		t.Errorf("Expected the file's own list to replace inherited ones, got %v", devCfg.ConventionalCommits.AllowedTypes)
+	}
+}
+
+func TestLoad_ExtendsErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{
+			name: "cycle",
+			files: map[string]string{
+				configFileName: "extends: a.yml\n",
+				"a.yml":        "extends: b.yml\n",
+				"b.yml":        "extends: a.yml\n",
+			},
+			expected: "b.yml:1:10: extends: cycle detected: ",
+		},
+		{
+			name:     "unknown preset",
+			files:    map[string]string{configFileName: "extends:\n  - preset:basic\n  - preset:nope\n"},
+			expected: configFileName + `:3:5: extends: unknown preset "nope"`,
+		},
+		{
+			name:     "missing file",
+			files:    map[string]string{configFileName: "extends: missing.yml\n"},
+			expected: configFileName + ":1:10: extends: cannot read extended configuration",
+		},
+		{
+			name: "invalid extended file",
+			files: map[string]string{
+				configFileName: "extends: base.yml\n",
+				"base.yml":     "validation:\n  scoring:\n    pass_treshold: 0.5\n",
+			},
+			expected: "base.yml:3:5: validation.scoring: Additional property pass_treshold is not allowed",
+		},
+		{
+			name: "decode error in extended file",
+			files: map[string]string{
+				configFileName: "extends: base.yml\nvalidation:\n  scoring:\n    pass_threshold: 0.5\n",
+				"base.yml":     "validation:\n  agents:\n    development-standards:\n      commit_analysis:\n        min_message_length: 99999999999999999999\n",
+			},
+			expected: "base.yml:5:9: cannot unmarshal",
+		},
+		{
+			name: "decode error below extends",
+			files: map[string]string{
+				configFileName: "# Shared settings first\nextends: base.yml\nvalidation:\n  agents:\n    development-standards:\n      commit_analysis:\n        max_message_length: 99999999999999999999\n",
+				"base.yml":     "validation:\n  scoring:\n    pass_threshold: 0.5\n",
+			},
+			expected: configFileName + ":7:9: cannot unmarshal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				writeConfig(t, filepath.Join(dir, name), content)
+			}
+
+			_, err := Load(dir)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
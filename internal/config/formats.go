@@ -0,0 +1,55 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// ParseDocument parses configuration data in the format named by the
+// extension of file: TOML for ".toml" and YAML otherwise, which covers JSON
+// as well. It returns a zero node for an empty document. Syntax errors are
+// returned as a *ConfigError.
+//
+// Nodes parsed from TOML carry no positions, so schema problems in TOML files
+// are reported without a line number.
+func ParseDocument(file string, data []byte) (*yaml.Node, error) {
+	if !strings.EqualFold(filepath.Ext(file), ".toml") {
+		var root yaml.Node
+		if err := yaml.Unmarshal(data, &root); err != nil {
+			return nil, yamlError(file, err)
+		}
+		return &root, nil
+	}
+
+	var document map[string]interface{}
+	if _, err := toml.Decode(string(data), &document); err != nil {
+		return nil, tomlError(file, err)
+	}
+	if len(document) == 0 {
+		return &yaml.Node{}, nil
+	}
+
+	var content yaml.Node
+	if err := content.Encode(document); err != nil {
+		return nil, &ConfigError{File: file, Problems: []Problem{{Message: err.Error()}}}
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{&content}}, nil
+}
+
+// tomlError converts a TOML parse error into a *ConfigError, keeping its
+// position.
+func tomlError(file string, err error) error {
+	var parseErr toml.ParseError
+	if errors.As(err, &parseErr) {
+		return &ConfigError{File: file, Problems: []Problem{{
+			Line:    parseErr.Position.Line,
+			Column:  parseErr.Position.Col,
+			Message: parseErr.Message,
+		}}}
+	}
+	return &ConfigError{File: file, Problems: []Problem{{Message: err.Error()}}}
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix starts the name of every environment variable that overrides a
+// setting.
+const EnvPrefix = "CBI_"
+
+// SourceFlag is the provenance of a setting given with --set.
+const SourceFlag = "--set"
+
+// Override replaces one setting after the configuration files are loaded.
+// Path is the dotted setting path, Value its YAML value, and Source names the
+// override in errors and provenance, such as "env:CBI_..." or "--set".
+type Override struct {
+	Path   string
+	Value  string
+	Source string
+}
+
+// ParseSetFlags parses --set values of the form "path=value".
+func ParseSetFlags(values []string) ([]Override, error) {
+	overrides := make([]Override, 0, len(values))
+	for _, value := range values {
+		path, raw, ok := strings.Cut(value, "=")
+		if !ok || path == "" {
+			return nil, fmt.Errorf("invalid --set value %q: expected path=value, e.g. validation.output.verbose=true", value)
+		}
+		overrides = append(overrides, Override{Path: path, Value: raw, Source: SourceFlag})
+	}
+	return overrides, nil
+}
+
+// EnvOverrides returns an override for every CBI_ variable in environ, given
+// in the "NAME=value" form of os.Environ and sorted by name. A CBI_ variable
+// that names no setting is an error, so misspelled names are not silently
+// ignored.
+func EnvOverrides(environ []string) ([]Override, error) {
+	settings, err := envSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	var overrides []Override
+	for _, variable := range environ {
+		name, value, _ := strings.Cut(variable, "=")
+		if !strings.HasPrefix(name, EnvPrefix) {
+			continue
+		}
+		path, ok := settings[name]
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s does not name a setting", name)
+		}
+		if path == "" {
+			return nil, fmt.Errorf("environment variable %s names more than one setting; write each '.' of the path as \"__\"", name)
+		}
+		overrides = append(overrides, Override{Path: path, Value: value, Source: "env:" + name})
+	}
+
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].Source < overrides[j].Source })
+	return overrides, nil
+}
+
+// envSettings maps the environment variables of every setting to its path.
+// Each setting has its EnvName and a short name with every '.' written as a
+// single '_'; a short name shared by several settings maps to "".
+func envSettings() (map[string]string, error) {
+	document, err := Effective(DefaultConfig())
+	if err != nil {
+		return nil, err
+	}
+	settings := map[string]string{}
+	walkSettings(document, "", func(path string, key, value *yaml.Node) {
+		settings[EnvName(path)] = path
+
+		short := strings.ReplaceAll(EnvName(path), "__", "_")
+		if existing, ok := settings[short]; ok && existing != path {
+			settings[short] = ""
+		} else {
+			settings[short] = path
+		}
+	})
+	return settings, nil
+}
+
+// EnvName returns the environment variable that overrides the setting at
+// path: CBI_ followed by the path in upper case, with each '.' written as
+// "__" and each '-' as '_'. CBI_VALIDATION__OUTPUT__VERBOSE sets
+// validation.output.verbose, as does the short form
+// CBI_VALIDATION_OUTPUT_VERBOSE.
+func EnvName(path string) string {
+	return EnvPrefix + strings.ToUpper(strings.NewReplacer(".", "__", "-", "_").Replace(path))
+}
+
+// ApplyOverrides returns cfg with overrides applied in order, so later ones
+// win, and records their sources in provenance. Each value is parsed as YAML,
+// so "true", "50", "30s" and "[feat, fix]" take the type the setting expects,
+// and must match the schema. A mapping value is merged into the setting like
+// an extending file; any other value replaces it.
+func ApplyOverrides(cfg *Config, provenance Provenance, overrides []Override) (*Config, error) {
+	if len(overrides) == 0 {
+		return cfg, nil
+	}
+
+	document, err := Effective(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, override := range overrides {
+		value, err := overrideValue(override)
+		if err != nil {
+			return nil, err
+		}
+
+		parts := strings.Split(override.Path, ".")
+		partial := value
+		for i := len(parts) - 1; i >= 0; i-- {
+			partial = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: parts[i]}, partial,
+			}}
+		}
+		if err := validateSchema(override.Source, partial); err != nil {
+			return nil, withoutPositions(err)
+		}
+
+		document.Content[0] = mergeNodes(document.Content[0], partial)
+
+		if value.Kind == yaml.MappingNode && len(value.Content) > 0 {
+			walkSettings(value, override.Path, func(path string, key, value *yaml.Node) {
+				provenance[path] = override.Source
+			})
+		} else {
+			provenance[override.Path] = override.Source
+		}
+	}
+
+	overridden := &Config{}
+	if err := document.Decode(overridden); err != nil {
+		return nil, fmt.Errorf("failed to apply overrides: %w", err)
+	}
+	if err := overridden.Validation.Scoring.Validate(); err != nil {
+		return nil, fmt.Errorf("validation.scoring: %w", err)
+	}
+	return overridden, nil
+}
+
+// overrideValue parses the value of an override as a YAML value. An empty
+// value is the empty string.
+func overrideValue(override Override) (*yaml.Node, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(override.Value), &root); err != nil {
+		return nil, &ConfigError{File: override.Source, Problems: []Problem{{
+			Field:   override.Path,
+			Message: fmt.Sprintf("invalid value %q: %s", override.Value, strings.TrimPrefix(err.Error(), "yaml: ")),
+		}}}
+	}
+	if root.Kind == 0 {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str"}, nil
+	}
+	return root.Content[0], nil
+}
+
+// withoutPositions drops the line and column of every problem in a
+// *ConfigError: an override is a single value, so they carry no meaning.
+func withoutPositions(err error) error {
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		return err
+	}
+	for i := range configErr.Problems {
+		configErr.Problems[i].Line = 0
+		configErr.Problems[i].Column = 0
+	}
+	return configErr
+}
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnvOverrides(t *testing.T) {
+	overrides, err := EnvOverrides([]string{
+		"PATH=/usr/bin",
+		"CBI_VALIDATION__OUTPUT__VERBOSE=true",
+		"CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPTH=50",
+	})
+	if err != nil {
+		t.Fatalf("EnvOverrides failed: %v", err)
+	}
+
+	expected := []Override{
+		{Path: "validation.agents.development-standards.commit_history_depth", Value: "50", Source: "env:CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPTH"},
+		{Path: "validation.output.verbose", Value: "true", Source: "env:CBI_VALIDATION__OUTPUT__VERBOSE"},
+	}
+	if len(overrides) != len(expected) {
+		t.Fatalf("Expected %d overrides, got %v", len(expected), overrides)
+	}
+	for i := range expected {
+		if overrides[i] != expected[i] {
+			t.Errorf("Expected override %d to be %+v, got %+v", i, expected[i], overrides[i])
+		}
+	}
+
+	for _, name := range []string{"CBI_VALIDATION_UNKNOWN", "CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPT"} {
+		if _, err := EnvOverrides([]string{name + "=1"}); err == nil || !strings.Contains(err.Error(), name+" does not name a setting") {
+			t.Errorf("Expected an error naming %s, got %v", name, err)
+		}
+	}
+}
+
+func TestEnvOverrides_InvalidValue(t *testing.T) {
+	overrides, err := EnvOverrides([]string{"CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPTH=abc"})
+	if err != nil {
+		t.Fatalf("EnvOverrides failed: %v", err)
+	}
+
+	_, err = ApplyOverrides(DefaultConfig(), Provenance{}, overrides)
+	if err == nil || !strings.Contains(err.Error(), "env:CBI_VALIDATION_AGENTS_DEVELOPMENT_STANDARDS_COMMIT_HISTORY_DEPTH") {
+		t.Errorf("Expected an error naming the variable, got %v", err)
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	tests := map[string]string{
+		"validation.output.verbose":                                    "CBI_VALIDATION__OUTPUT__VERBOSE",
+		"validation.agents.development-standards.commit_history_depth": "CBI_VALIDATION__AGENTS__DEVELOPMENT_STANDARDS__COMMIT_HISTORY_DEPTH",
+	}
+	for path, expected := range tests {
+		if name := EnvName(path); name != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, path, name)
+		}
+	}
+}
+
+func TestParseSetFlags(t *testing.T) {
+	overrides, err := ParseSetFlags([]string{"validation.output.format=json", "validation.agents.essential-files.docs_directory="})
+	if err != nil {
+		t.Fatalf("ParseSetFlags failed: %v", err)
+	}
+	if len(overrides) != 2 || overrides[0].Path != "validation.output.format" || overrides[0].Value != "json" || overrides[1].Value != "" {
+		t.Errorf("Unexpected overrides: %+v", overrides)
+	}
+
+	for _, value := range []string{"validation.output.verbose", "=true"} {
+		if _, err := ParseSetFlags([]string{value}); err == nil {
+			t.Errorf("Expected an error for %q", value)
+		}
+	}
+}
+
+func TestApplyOverrides(t *testing.T) {
+	cfg := DefaultConfig()
+	provenance := Provenance{}
+	overrides := []Override{
+		{Path: "validation.agents.development-standards.commit_history_depth", Value: "50", Source: "env:CBI_X"},
+		{Path: "validation.agents.development-standards.commit_history_depth", Value: "25", Source: SourceFlag},
+		{Path: "validation.agents.git-configuration.require_gitattributes", Value: "true", Source: SourceFlag},
+		{Path: "validation.agents.essential-files.timeout", Value: "30s", Source: SourceFlag},
+		{Path: "validation.agents.development-standards.conventional_commits.allowed_types", Value: "[feat, fix]", Source: SourceFlag},
+		{Path: "validation.scoring", Value: "{pass_threshold: 0.95}", Source: SourceFlag},
+	}
+
+	overridden, err := ApplyOverrides(cfg, provenance, overrides)
+	if err != nil {
+		t.Fatalf("ApplyOverrides failed: %v", err)
+	}
+
+	standards := overridden.Validation.Agents.DevelopmentStandards
+	if standards.CommitHistoryDepth != 25 {
+		t.Errorf("Expected the last override to win, got commit_history_depth %d", standards.CommitHistoryDepth)
+	}
+	if !overridden.Validation.Agents.GitConfiguration.RequireGitattributes {
+		t.Error("Expected require_gitattributes to be overridden")
+	}
+	if overridden.Validation.Agents.EssentialFiles.Timeout != 30*time.Second {
+		t.Errorf("Expected a 30s timeout, got %s", overridden.Validation.Agents.EssentialFiles.Timeout)
+	}
+	if got := strings.Join(standards.ConventionalCommits.AllowedTypes, ","); got != "feat,fix" {
+		t.Errorf("Expected allowed_types to be replaced, got %s", got)
+	}
+	if overridden.Validation.Scoring.PassThreshold != 0.95 || overridden.Validation.Scoring.WarningThreshold != 0.6 {
+		t.Errorf("Expected scoring to be merged, got %+v", overridden.Validation.Scoring)
+	}
+	if !cfg.Validation.Agents.EssentialFiles.Enabled || cfg.Validation.Agents.DevelopmentStandards.CommitHistoryDepth != 10 {
+		t.Error("Expected the original configuration to be left unchanged")
+	}
+
+	sources := map[string]string{
+		"validation.agents.development-standards.commit_history_depth": SourceFlag,
+		"validation.scoring.pass_threshold":                            SourceFlag,
+		"validation.scoring.warning_threshold":                         SourceDefault,
+	}
+	for path, expected := range sources {
+		if source := provenance.Source(path); source != expected {
+			t.Errorf("Expected %s to come from %s, got %s", path, expected, source)
+		}
+	}
+}
+
+func TestApplyOverrides_Invalid(t *testing.T) {
+	tests := []struct {
+		override Override
+		expected string
+	}{
+		{
+			override: Override{Path: "validation.agents.unknown", Value: "true", Source: SourceFlag},
+			expected: "--set: validation.agents: Additional property unknown is not allowed",
+		},
+		{
+			override: Override{Path: "validation.output.verbose", Value: "maybe", Source: "env:CBI_VALIDATION__OUTPUT__VERBOSE"},
+			expected: "env:CBI_VALIDATION__OUTPUT__VERBOSE: validation.output.verbose: Invalid type. Expected: boolean, given: string",
+		},
+		{
+			override: Override{Path: "validation.scoring.pass_threshold", Value: "2", Source: SourceFlag},
+			expected: "--set: validation.scoring.pass_threshold: Must be less than or equal to 1",
+		},
+		{
+			override: Override{Path: "validation.scoring.warning_threshold", Value: "0.9", Source: SourceFlag},
+			expected: "validation.scoring: warning_threshold 0.9 is above pass_threshold 0.8",
+		},
+		{
+			override: Override{Path: "validation.output.format", Value: "[json", Source: SourceFlag},
+			expected: `--set: validation.output.format: invalid value "[json"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.override.Path, func(t *testing.T) {
+			_, err := ApplyOverrides(DefaultConfig(), Provenance{}, []Override{tt.override})
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.HasPrefix(err.Error(), tt.expected) {
+				t.Errorf("Expected error starting with %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
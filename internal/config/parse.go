@@ -0,0 +1,330 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/codebase-interface/cli/cmd/schema"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+// Problem is one mistake in a configuration file, positioned at the key or
+// value it concerns. Column is zero when the YAML parser does not report it.
+// File is set when the problem is in a configuration the file extends.
+type Problem struct {
+	File    string
+	Line    int
+	Column  int
+	Field   string
+	Message string
+}
+
+// String formats the problem as "line:column: field: message", leaving out
+// the parts that are unknown.
+func (p Problem) String() string {
+	message := p.Message
+	if p.Field != "" {
+		message = p.Field + ": " + message
+	}
+
+	switch {
+	case p.Line == 0:
+		return message
+	case p.Column == 0:
+		return fmt.Sprintf("%d: %s", p.Line, message)
+	default:
+		return fmt.Sprintf("%d:%d: %s", p.Line, p.Column, message)
+	}
+}
+
+// ConfigError lists every problem found in a configuration file.
+type ConfigError struct {
+	File     string
+	Problems []Problem
+}
+
+func (e *ConfigError) Error() string {
+	return strings.Join(e.Messages(), "\n")
+}
+
+// Messages returns one "file:line:column: message" line per problem.
+func (e *ConfigError) Messages() []string {
+	messages := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		file := e.File
+		if problem.File != "" {
+			file = problem.File
+		}
+		if problem.Line == 0 {
+			messages[i] = file + ": " + problem.String()
+		} else {
+			messages[i] = file + ":" + problem.String()
+		}
+	}
+	return messages
+}
+
+// yamlLineMessage matches the "line N: message" form of yaml.v3 errors.
+var yamlLineMessage = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// Parse decodes configuration data over the defaults, merged over the
+// configurations it extends. Every document must match the embedded JSON
+// schema and use only known keys; file names the data in the returned
+// *ConfigError and anchors relative extends paths.
+func Parse(file string, data []byte) (*Config, error) {
+	cfg, _, err := parseWithProvenance(file, data)
+	return cfg, err
+}
+
+// parseWithProvenance is Parse that also reports which file set each
+// setting.
+func parseWithProvenance(file string, data []byte) (*Config, Provenance, error) {
+	key, err := filepath.Abs(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	origins := map[*yaml.Node]string{}
+	document, err := resolveDocument(file, key, data, nil, origins)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := DefaultConfig()
+	provenance := Provenance{}
+	if document == nil {
+		return cfg, provenance, nil
+	}
+
+	merged, err := yaml.Marshal(document)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to merge configuration: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(merged))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, nil, decodeError(file, err, document, merged, origins)
+	}
+
+	collectProvenance(document, origins, provenance)
+	if err := cfg.Validation.Scoring.Validate(); err != nil {
+		return nil, nil, scoringError(file, err, document, origins)
+	}
+	return cfg, provenance, nil
+}
+
+// scoringError reports thresholds that are out of order at the
+// warning_threshold or pass_threshold key that set them, in the file it came
+// from.
+func scoringError(file string, err error, document *yaml.Node, origins map[*yaml.Node]string) error {
+	problem := Problem{Field: "validation.scoring", Message: err.Error()}
+
+	keys := map[string]*yaml.Node{}
+	walkSettings(document, "", func(path string, key, value *yaml.Node) {
+		keys[path] = key
+	})
+	for _, path := range []string{"validation.scoring.warning_threshold", "validation.scoring.pass_threshold"} {
+		if key, ok := keys[path]; ok {
+			problem.Line, problem.Column = key.Line, key.Column
+			if origin := origins[key]; origin != file {
+				problem.File = origin
+			}
+			break
+		}
+	}
+	return &ConfigError{File: file, Problems: []Problem{problem}}
+}
+
+// decodeError converts an error from decoding the merged document into a
+// *ConfigError. The decoder reports lines of merged, so each problem is moved
+// to the source node on that line and the file it came from.
+func decodeError(file string, err error, document *yaml.Node, merged []byte, origins map[*yaml.Node]string) error {
+	configErr := yamlError(file, err).(*ConfigError)
+
+	var remarshaled yaml.Node
+	if err := yaml.Unmarshal(merged, &remarshaled); err != nil || len(remarshaled.Content) == 0 {
+		return configErr
+	}
+
+	for i, problem := range configErr.Problems {
+		if problem.Line == 0 {
+			continue
+		}
+
+		// A line that cannot be traced is left out rather than reported
+		// against the wrong file.
+		problem.Line = 0
+		if source := sourceNode(document, remarshaled.Content[0], configErr.Problems[i].Line); source != nil {
+			problem.Line, problem.Column = source.Line, source.Column
+			if origin := nodeOrigin(source, origins); origin != file {
+				problem.File = origin
+			}
+		}
+		configErr.Problems[i] = problem
+	}
+	return configErr
+}
+
+// sourceNode returns the first node of source, in document order, whose
+// counterpart in remarshaled is on line.
+func sourceNode(source, remarshaled *yaml.Node, line int) *yaml.Node {
+	if remarshaled.Line == line {
+		return source
+	}
+	if len(source.Content) != len(remarshaled.Content) {
+		return nil
+	}
+	for i := range remarshaled.Content {
+		if found := sourceNode(source.Content[i], remarshaled.Content[i], line); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// nodeOrigin returns the file a node came from. Mappings built by merging
+// are copies of the base mapping and start with its first key, which is
+// looked up instead.
+func nodeOrigin(node *yaml.Node, origins map[*yaml.Node]string) string {
+	for node != nil {
+		if origin, ok := origins[node]; ok {
+			return origin
+		}
+		if len(node.Content) == 0 {
+			break
+		}
+		node = node.Content[0]
+	}
+	return ""
+}
+
+// validateSchema checks the parsed document against the embedded schema and
+// reports each violation at the position of the offending node.
+func validateSchema(file string, root *yaml.Node) error {
+	var document interface{}
+	if err := root.Decode(&document); err != nil {
+		return yamlError(file, err)
+	}
+
+	configSchema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema.JSON))
+	if err != nil {
+		return fmt.Errorf("failed to load configuration schema: %w", err)
+	}
+
+	result, err := configSchema.Validate(gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return &ConfigError{File: file, Problems: []Problem{{Line: root.Line, Column: root.Column, Message: err.Error()}}}
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	configErr := &ConfigError{File: file}
+	for _, resultErr := range result.Errors() {
+		field := resultErr.Field()
+		if field == "(root)" {
+			field = ""
+		}
+		property, _ := resultErr.Details()["property"].(string)
+
+		line, column := nodePosition(root, field, property)
+		configErr.Problems = append(configErr.Problems, Problem{
+			Line:    line,
+			Column:  column,
+			Field:   field,
+			Message: resultErr.Description(),
+		})
+	}
+
+	sort.SliceStable(configErr.Problems, func(i, j int) bool {
+		a, b := configErr.Problems[i], configErr.Problems[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Message < b.Message
+	})
+	return configErr
+}
+
+// nodePosition finds the node at a dotted schema field path such as
+// "validation.agents.essential-files.custom_files.0". It returns the position
+// of the named property's key when there is one, otherwise of the deepest
+// node on the path that exists.
+func nodePosition(root *yaml.Node, field, property string) (int, int) {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line, column := node.Line, node.Column
+
+	var path []string
+	if field != "" {
+		path = strings.Split(field, ".")
+	}
+	if property != "" {
+		path = append(path, property)
+	}
+
+	for _, part := range path {
+		switch node.Kind {
+		case yaml.MappingNode:
+			var next *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == part {
+					line, column = node.Content[i].Line, node.Content[i].Column
+					next = node.Content[i+1]
+					break
+				}
+			}
+			if next == nil {
+				return line, column
+			}
+			node = next
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(node.Content) {
+				return line, column
+			}
+			node = node.Content[index]
+			line, column = node.Line, node.Column
+		default:
+			return line, column
+		}
+	}
+
+	return line, column
+}
+
+// yamlError converts a yaml.v3 error into a *ConfigError, keeping the line
+// numbers the parser reports. yaml.v3 does not report columns.
+func yamlError(file string, err error) error {
+	var messages []string
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	} else {
+		messages = []string{err.Error()}
+	}
+
+	configErr := &ConfigError{File: file}
+	for _, message := range messages {
+		problem := Problem{Message: strings.TrimPrefix(message, "yaml: ")}
+		if match := yamlLineMessage.FindStringSubmatch(message); match != nil {
+			problem.Line, _ = strconv.Atoi(match[1])
+			problem.Message = match[2]
+		}
+		configErr.Problems = append(configErr.Problems, problem)
+	}
+	return configErr
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+)
+
+//go:embed presets/*.yml
+var presetFS embed.FS
+
+// PresetNames lists the built-in presets in the order they are offered. The
+// same files back init-config and "extends: preset:NAME".
+var PresetNames = []string{"basic", "strict", "beginner", "open-source", "go-project"}
+
+// presetPrefix marks an extends entry that names a built-in preset.
+const presetPrefix = "preset:"
+
+// Preset returns the YAML of a built-in preset.
+func Preset(name string) ([]byte, error) {
+	for _, preset := range PresetNames {
+		if preset == name {
+			return presetFS.ReadFile("presets/" + name + ".yml")
+		}
+	}
+	return nil, fmt.Errorf("unknown preset %q (available: %s)", name, strings.Join(PresetNames, ", "))
+}
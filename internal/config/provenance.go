@@ -0,0 +1,167 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SourceDefault is the provenance of a setting no configuration file sets.
+const SourceDefault = "default"
+
+// Provenance maps a setting, as a dotted path such as
+// "validation.scoring.pass_threshold", to where its value came from: a file
+// name or "preset:NAME". Settings that are not listed keep their default.
+type Provenance map[string]string
+
+// Source returns where the setting at path came from.
+func (p Provenance) Source(path string) string {
+	if source, ok := p[path]; ok {
+		return source
+	}
+	return SourceDefault
+}
+
+// Sources returns the source of every setting in an Effective document.
+func (p Provenance) Sources(document *yaml.Node) map[string]string {
+	sources := map[string]string{}
+	walkSettings(document, "", func(path string, key, value *yaml.Node) {
+		sources[path] = p.Source(path)
+	})
+	return sources
+}
+
+// Annotate adds a comment with its source to every setting in an Effective
+// document.
+func (p Provenance) Annotate(document *yaml.Node) {
+	walkSettings(document, "", func(path string, key, value *yaml.Node) {
+		if value.Kind == yaml.ScalarNode || value.Style == yaml.FlowStyle {
+			value.LineComment = p.Source(path)
+		} else {
+			key.LineComment = p.Source(path)
+		}
+	})
+}
+
+// recordOrigins notes file as the origin of node and everything below it.
+func recordOrigins(node *yaml.Node, file string, origins map[*yaml.Node]string) {
+	origins[node] = file
+	for _, child := range node.Content {
+		recordOrigins(child, file, origins)
+	}
+}
+
+// collectProvenance records the origin of every setting in a merged
+// document.
+func collectProvenance(document *yaml.Node, origins map[*yaml.Node]string, provenance Provenance) {
+	walkSettings(document, "", func(path string, key, value *yaml.Node) {
+		if origin, ok := origins[value]; ok {
+			provenance[path] = origin
+		}
+	})
+}
+
+// walkSettings calls fn for every setting below a mapping node. A setting
+// is any value other than a mapping, so lists count as a single setting.
+func walkSettings(node *yaml.Node, prefix string, fn func(path string, key, value *yaml.Node)) {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		path := key.Value
+		if prefix != "" {
+			path = prefix + "." + key.Value
+		}
+
+		if value.Kind == yaml.MappingNode && len(value.Content) > 0 {
+			walkSettings(value, path, fn)
+			continue
+		}
+		fn(path, key, value)
+	}
+}
+
+// Effective returns cfg as a YAML document in the same shape as a
+// configuration file, with durations written as "30s" and empty lists as
+// "[]", so the output is itself a valid configuration.
+func Effective(cfg *Config) (*yaml.Node, error) {
+	node, err := encodeValue(reflect.ValueOf(*cfg))
+	if err != nil {
+		return nil, err
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{node}}, nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func encodeValue(value reflect.Value) (*yaml.Node, error) {
+	if value.Type() == durationType {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: time.Duration(value.Int()).String()}, nil
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for i := 0; i < value.NumField(); i++ {
+			name := strings.Split(value.Type().Field(i).Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			child, err := encodeValue(value.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}, child)
+		}
+		return node, nil
+
+	case reflect.Slice:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if value.Len() == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		for i := 0; i < value.Len(); i++ {
+			child, err := encodeValue(value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+		return node, nil
+
+	case reflect.Map:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if value.Len() == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		keys := make([]string, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child, err := encodeValue(value.MapIndex(reflect.ValueOf(key)))
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+		}
+		return node, nil
+
+	default:
+		node := &yaml.Node{}
+		if err := node.Encode(value.Interface()); err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", value.Type(), err)
+		}
+		return node, nil
+	}
+}
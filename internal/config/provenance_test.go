@@ -0,0 +1,93 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLoadWithProvenance(t *testing.T) {
+	root := t.TempDir()
+	writeConfig(t, filepath.Join(root, "base.yml"), "extends: preset:strict\nvalidation:\n  scoring:\n    pass_threshold: 0.9\n")
+	configPath := filepath.Join(root, configFileName)
+	writeConfig(t, configPath, "extends: base.yml\nvalidation:\n  agents:\n    development-standards:\n      timeout: 30s\n")
+
+	_, provenance, err := LoadWithProvenance(root)
+	if err != nil {
+		t.Fatalf("LoadWithProvenance failed: %v", err)
+	}
+
+	tests := map[string]string{
+		"validation.agents.development-standards.timeout":       configPath,
+		"validation.scoring.pass_threshold":                     filepath.Join(root, "base.yml"),
+		"validation.scoring.warning_threshold":                  "preset:strict",
+		"validation.agents.essential-files.docs_directory":      SourceDefault,
+		"validation.agents.git-configuration.require_gitignore": "preset:strict",
+	}
+	for path, expected := range tests {
+		if source := provenance.Source(path); source != expected {
+			t.Errorf("Expected %s to come from %s, got %s", path, expected, source)
+		}
+	}
+}
+
+func TestEffective(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Validation.Agents.DevelopmentStandards.Timeout = 90 * time.Second
+	cfg.Validation.Agents.GitConfiguration.GitignoreValidation.RequiredPatterns = map[string][]string{"go": {"*.test"}}
+
+	document, err := Effective(cfg)
+	if err != nil {
+		t.Fatalf("Effective failed: %v", err)
+	}
+	output, err := yaml.Marshal(document)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	for _, expected := range []string{"timeout: 1m30s", "custom_files: []", "go:\n                        - '*.test'"} {
+		if !strings.Contains(string(output), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+
+	// The effective configuration is itself a valid configuration file that
+	// loads back to the same settings.
+	reloaded, err := Parse("effective.yml", output)
+	if err != nil {
+		t.Fatalf("Expected effective configuration to parse: %v", err)
+	}
+	again, err := Effective(reloaded)
+	if err != nil {
+		t.Fatalf("Effective failed: %v", err)
+	}
+	againOutput, err := yaml.Marshal(again)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(againOutput) != string(output) {
+		t.Errorf("Expected a round trip to keep every setting:\n%s\ngot:\n%s", output, againOutput)
+	}
+}
+
+func TestProvenance_Annotate(t *testing.T) {
+	document, err := Effective(DefaultConfig())
+	if err != nil {
+		t.Fatalf("Effective failed: %v", err)
+	}
+
+	Provenance{"validation.scoring.pass_threshold": "team.yml"}.Annotate(document)
+	output, err := yaml.Marshal(document)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	for _, expected := range []string{"pass_threshold: 0.8 # team.yml", "warning_threshold: 0.6 # default", "allowed_types: # default"} {
+		if !strings.Contains(string(output), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+import "fmt"
+
+// Overall statuses produced by ScoringConfig.Summarize.
+const (
+	StatusPass    = "pass"
+	StatusWarning = "warning"
+	StatusFail    = "fail"
+)
+
+type ScoringConfig struct {
+	PassThreshold    float64 `yaml:"pass_threshold"`
+	WarningThreshold float64 `yaml:"warning_threshold"`
+}
+
+// Summary is the aggregate outcome of a validation run. It is computed once
+// from the per-agent scores and shared by the exit code and every formatter.
+// Agents that could not run are counted in Errors rather than scored. When
+// FailOn is set, findings of that severity or worse decide Passed instead of
+// the score.
+type Summary struct {
+	Score            float64 `json:"score"`
+	Status           string  `json:"status"` // pass, warning, fail
+	Passed           bool    `json:"passed"`
+	PassThreshold    float64 `json:"pass_threshold"`
+	WarningThreshold float64 `json:"warning_threshold"`
+	Agents           int     `json:"agents"`
+	Errors           int     `json:"errors"`
+	FailOn           string  `json:"fail_on,omitempty"`
+}
+
+// Summarize averages the agent scores and grades the result against the
+// configured thresholds. A score at or above pass_threshold passes, a score
+// at or above warning_threshold is a (non-critical) failure reported as a
+// warning, and anything lower is a critical failure.
+func (s ScoringConfig) Summarize(scores []float64) Summary {
+	summary := Summary{
+		Score:            1.0,
+		PassThreshold:    s.PassThreshold,
+		WarningThreshold: s.WarningThreshold,
+		Agents:           len(scores),
+	}
+
+	if len(scores) > 0 {
+		var total float64
+		for _, score := range scores {
+			total += score
+		}
+		summary.Score = total / float64(len(scores))
+	}
+
+	switch {
+	case summary.Score >= s.PassThreshold:
+		summary.Status = StatusPass
+		summary.Passed = true
+	case summary.Score >= s.WarningThreshold:
+		summary.Status = StatusWarning
+	default:
+		summary.Status = StatusFail
+	}
+
+	return summary
+}
+
+// Validate checks that the thresholds are in order: a score cannot pass
+// while being below the warning threshold.
+func (s ScoringConfig) Validate() error {
+	if s.WarningThreshold > s.PassThreshold {
+		return fmt.Errorf("warning_threshold %g is above pass_threshold %g", s.WarningThreshold, s.PassThreshold)
+	}
+	return nil
+}
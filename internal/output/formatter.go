@@ -8,10 +8,11 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
 )
 
 type Formatter interface {
-	Format(results []agents.ValidationResult, writer io.Writer) error
+	Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error
 }
 
 func NewFormatter(format string) (Formatter, error) {
@@ -20,6 +21,16 @@ func NewFormatter(format string) (Formatter, error) {
 		return &JSONFormatter{}, nil
 	case "table":
 		return &TableFormatter{}, nil
+	case "sarif":
+		return &SARIFFormatter{}, nil
+	case "junit":
+		return &JUnitFormatter{}, nil
+	case "markdown":
+		return &MarkdownFormatter{}, nil
+	case "html":
+		return &HTMLFormatter{}, nil
+	case "github":
+		return &GitHubFormatter{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported format: %s", format)
 	}
@@ -27,111 +38,141 @@ func NewFormatter(format string) (Formatter, error) {
 
 type JSONFormatter struct{}
 
-func (f *JSONFormatter) Format(results []agents.ValidationResult, writer io.Writer) error {
+type jsonReport struct {
+	Summary config.Summary            `json:"summary"`
+	Results []agents.ValidationResult `json:"results"`
+}
+
+func (f *JSONFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
 	encoder := json.NewEncoder(writer)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(results)
+	return encoder.Encode(jsonReport{Summary: summary, Results: results})
 }
 
 type TableFormatter struct{}
 
-func (f *TableFormatter) Format(results []agents.ValidationResult, writer io.Writer) error {
+func (f *TableFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
 	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Bold(true)
 	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Bold(true)
 	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("3")).Bold(true)
 	infoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("4"))
 
+	styles := map[string]lipgloss.Style{
+		"pass":    successStyle,
+		"fail":    failStyle,
+		"warning": warningStyle,
+		"error":   failStyle,
+	}
+	styleFor := func(status string) lipgloss.Style {
+		if style, ok := styles[status]; ok {
+			return style
+		}
+		return infoStyle
+	}
+
 	var output strings.Builder
-	var totalScore float64
-	var criticalFailures bool
 
 	for _, result := range results {
-		totalScore += result.Score
-
-		var statusSymbol, statusText string
-		var statusStyle lipgloss.Style
-
-		switch result.Status {
-		case "pass":
-			statusSymbol = "✓"
-			statusText = "PASS"
-			statusStyle = successStyle
-		case "fail":
-			statusSymbol = "✗"
-			statusText = "FAIL"
-			statusStyle = failStyle
-			criticalFailures = true
-		case "warning":
-			statusSymbol = "⚠"
-			statusText = "WARN"
-			statusStyle = warningStyle
-		default:
-			statusSymbol = "?"
-			statusText = "UNKNOWN"
-			statusStyle = infoStyle
-		}
+		statusSymbol, statusText := statusLabel(result.Status)
 
-		agentTitle := fmt.Sprintf("%s %s Agent - %s (Score: %.1f)",
+		title := fmt.Sprintf("%s %s Agent - %s (Score: %.1f)",
 			statusSymbol,
-			strings.Title(strings.ReplaceAll(result.Agent, "-", " ")),
+			agentTitle(result.Agent),
 			statusText,
 			result.Score,
 		)
 
-		output.WriteString(statusStyle.Render(agentTitle))
+		output.WriteString(styleFor(result.Status).Render(title))
 		output.WriteString("\n")
 
+		if result.Error != "" {
+			output.WriteString(styleFor(result.Status).Render("  Error: " + result.Error))
+			output.WriteString("\n")
+		}
+
 		for _, finding := range result.Findings {
-			var symbol string
-			var style lipgloss.Style
-
-			switch finding.Severity {
-			case "critical":
-				if finding.Type == "missing" || finding.Type == "invalid" {
-					symbol = "  ✗"
-					style = failStyle
-				} else {
-					symbol = "  ✓"
-					style = successStyle
-				}
-			case "warning":
-				symbol = "  ⚠"
-				style = warningStyle
-			case "info":
-				symbol = "  ✓"
-				style = successStyle
-			default:
-				symbol = "  ℹ"
-				style = infoStyle
-			}
-
-			findingText := fmt.Sprintf("%s %s", symbol, finding.Message)
-			output.WriteString(style.Render(findingText))
+			symbol, status := findingLabel(finding)
+			findingText := fmt.Sprintf("  %s %s", symbol, finding.Message)
+			output.WriteString(styleFor(status).Render(findingText))
 			output.WriteString("\n")
 		}
 
 		output.WriteString("\n")
 	}
 
-	overallScore := totalScore / float64(len(results))
-	var overallStatus string
-	var overallStyle lipgloss.Style
-
-	if criticalFailures || overallScore < 0.8 {
-		overallStatus = "FAIL"
-		overallStyle = failStyle
-	} else if overallScore < 1.0 {
-		overallStatus = "PASS (with warnings)"
-		overallStyle = warningStyle
-	} else {
-		overallStatus = "PASS"
-		overallStyle = successStyle
-	}
-
-	overallText := fmt.Sprintf("Overall Score: %.2f - %s", overallScore, overallStatus)
-	output.WriteString(overallStyle.Render(overallText))
+	statusText, status := overallStatus(summary)
+	overallText := fmt.Sprintf("Overall Score: %.2f - %s (pass: %.2f, warning: %.2f)",
+		summary.Score,
+		statusText,
+		summary.PassThreshold,
+		summary.WarningThreshold,
+	)
+	output.WriteString(styleFor(status).Render(overallText))
 	output.WriteString("\n")
 
 	_, err := writer.Write([]byte(output.String()))
 	return err
 }
+
+// statusLabel returns the symbol and label shown for an agent status.
+func statusLabel(status string) (string, string) {
+	switch status {
+	case "pass":
+		return "✓", "PASS"
+	case "fail":
+		return "✗", "FAIL"
+	case "warning":
+		return "⚠", "WARN"
+	case agents.StatusError:
+		return "!", "ERROR"
+	default:
+		return "?", "UNKNOWN"
+	}
+}
+
+// findingLabel returns the symbol for a finding and the status whose style
+// it is shown in.
+func findingLabel(finding agents.Finding) (string, string) {
+	switch finding.Severity {
+	case "critical":
+		if finding.Type == "missing" || finding.Type == "invalid" {
+			return "✗", "fail"
+		}
+		return "✓", "pass"
+	case "warning":
+		return "⚠", "warning"
+	case "info":
+		return "✓", "pass"
+	default:
+		return "ℹ", ""
+	}
+}
+
+// overallStatus describes the summary status, distinguishing a pass with
+// warnings from a clean pass. Agents that could not run override the score.
+// The second value is the status whose style it is shown in.
+func overallStatus(summary config.Summary) (string, string) {
+	if summary.Errors > 0 {
+		return fmt.Sprintf("ERROR (%d of %d agents could not run)", summary.Errors, summary.Agents), "fail"
+	}
+
+	if summary.FailOn != "" && !summary.Passed {
+		return fmt.Sprintf("FAIL (%s findings)", summary.FailOn), "fail"
+	}
+
+	switch summary.Status {
+	case config.StatusFail:
+		return "FAIL", "fail"
+	case config.StatusWarning:
+		return "FAIL (below pass threshold)", "warning"
+	default:
+		if summary.Score < 1.0 {
+			return "PASS (with warnings)", "warning"
+		}
+		return "PASS", "pass"
+	}
+}
+
+func agentTitle(agent string) string {
+	return strings.Title(strings.ReplaceAll(agent, "-", " "))
+}
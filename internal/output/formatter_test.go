@@ -0,0 +1,369 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+func testResults() ([]agents.ValidationResult, config.Summary) {
+	results := []agents.ValidationResult{
+		{
+			Agent:  "essential-files",
+			Status: "fail",
+			Score:  0.5,
+			Findings: []agents.Finding{
+				{Check: "readme", Type: "present", File: "README.md", Message: "README.md present", Severity: "info"},
+				{Check: "contributing", Type: "missing", File: "CONTRIBUTING.md", Message: "CONTRIBUTING.md missing", Severity: "critical"},
+			},
+		},
+		{
+			Agent:  "git-configuration",
+			Status: "pass",
+			Score:  1.0,
+			Findings: []agents.Finding{
+				{Check: "editorconfig-compliance", Type: "invalid", File: "main.go", Line: 4, Message: "main.go:4: trailing whitespace", Severity: "warning"},
+				{Check: "editorconfig-compliance", Type: "invalid", File: "util.go", Line: 9, Message: "util.go:9: trailing whitespace", Severity: "warning"},
+			},
+		},
+	}
+
+	return results, config.DefaultConfig().Validation.Scoring.Summarize([]float64{0.5, 1.0})
+}
+
+func TestNewFormatter(t *testing.T) {
+	for _, format := range []string{"json", "table", "sarif", "junit", "markdown", "html", "github"} {
+		if _, err := NewFormatter(format); err != nil {
+			t.Errorf("Expected format %q to be supported: %v", format, err)
+		}
+	}
+
+	if _, err := NewFormatter("xml"); err == nil {
+		t.Error("Expected unsupported format to return an error")
+	}
+}
+
+func TestSARIFFormatter(t *testing.T) {
+	results, summary := testResults()
+
+	var buf bytes.Buffer
+	if err := (&SARIFFormatter{}).Format(results, summary, &buf); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("Expected one SARIF 2.1.0 run, got version %q with %d runs", log.Version, len(log.Runs))
+	}
+	run := log.Runs[0]
+
+	if len(run.Tool.Extensions) != 2 {
+		t.Fatalf("Expected one tool extension per agent, got %d", len(run.Tool.Extensions))
+	}
+	if rules := run.Tool.Extensions[1].Rules; len(rules) != 1 || rules[0].ID != "git-configuration/editorconfig-compliance" {
+		t.Errorf("Expected findings of one check to share a rule, got %+v", rules)
+	}
+
+	if len(run.Results) != 3 {
+		t.Fatalf("Expected info findings to be skipped, got %d results", len(run.Results))
+	}
+
+	missing := run.Results[0]
+	if missing.RuleID != "essential-files/contributing" || missing.Level != "error" {
+		t.Errorf("Expected critical finding to be an error for essential-files/contributing, got %+v", missing)
+	}
+	if len(missing.Locations) != 0 {
+		t.Errorf("Expected a missing file to have no location, got %+v", missing.Locations)
+	}
+	if uri := run.Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "main.go" {
+		t.Errorf("Expected artifact location main.go, got %s", uri)
+	}
+
+	whitespace := run.Results[2]
+	if whitespace.Level != "warning" || whitespace.Rule.ToolComponent.Index != 1 {
+		t.Errorf("Expected warning from the second tool component, got %+v", whitespace)
+	}
+	if region := whitespace.Locations[0].PhysicalLocation.Region; region == nil || region.StartLine != 9 {
+		t.Errorf("Expected region starting at line 9, got %+v", region)
+	}
+	if whitespace.PartialFingerprints["findingHash/v1"] == run.Results[1].PartialFingerprints["findingHash/v1"] {
+		t.Error("Expected distinct findings to have distinct fingerprints")
+	}
+
+	var again bytes.Buffer
+	if err := (&SARIFFormatter{}).Format(results, summary, &again); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), again.Bytes()) {
+		t.Error("Expected identical input to produce identical SARIF output")
+	}
+}
+
+func TestSARIFFormatter_StableRules(t *testing.T) {
+	findings := func(count int, sha string) []agents.Finding {
+		return []agents.Finding{
+			{Check: "commit-messages", Type: "invalid", File: agents.FileGitHistory, Message: "Commit " + sha + " violates header-max-length", Severity: "warning"},
+			{Check: "conventional-commits", Type: "invalid", File: agents.FileGitHistory, Message: fmt.Sprintf("%d of 10 recent commits follow conventional commit rules", count), Severity: "critical"},
+			{Check: "commit-messages", Type: "invalid", File: agents.FileGitHistory, Message: "Commit 1111111 violates type-enum", Severity: "warning"},
+			{Check: "branch-naming", Type: "invalid", File: agents.FileGitBranch, Message: "Branch name doesn't follow conventions", Severity: "critical"},
+			{Check: "branch-naming", Type: "missing", File: agents.FileGitBranch, Message: "Not a git repository", Severity: "warning"},
+		}
+	}
+
+	format := func(count int, sha string) sarifRun {
+		results := []agents.ValidationResult{{Agent: "development-standards", Status: "fail", Findings: findings(count, sha)}}
+		var buf bytes.Buffer
+		if err := (&SARIFFormatter{}).Format(results, config.Summary{}, &buf); err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+		var log sarifLog
+		if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+			t.Fatalf("Output is not valid JSON: %v", err)
+		}
+		return log.Runs[0]
+	}
+
+	run := format(3, "abc1234")
+	rules := run.Tool.Extensions[0].Rules
+	if len(rules) != 3 {
+		t.Fatalf("Expected one rule per check, got %+v", rules)
+	}
+	if level := rules[2].DefaultConfiguration.Level; rules[2].ID != "development-standards/branch-naming" || level != "error" {
+		t.Errorf("Expected the rule level to be its most severe result, got %s for %s", level, rules[2].ID)
+	}
+
+	for _, result := range run.Results {
+		if len(result.Locations) != 0 {
+			t.Errorf("Expected no location for pseudo-path results, got %+v", result.Locations)
+		}
+	}
+	if run.Results[0].PartialFingerprints["findingHash/v1"] == run.Results[2].PartialFingerprints["findingHash/v1"] {
+		t.Error("Expected findings sharing a rule and location to have distinct fingerprints")
+	}
+
+	// Counts and commit hashes in messages do not change the fingerprints.
+	again := format(7, "def5678")
+	for i := range run.Results {
+		if run.Results[i].PartialFingerprints["findingHash/v1"] != again.Results[i].PartialFingerprints["findingHash/v1"] {
+			t.Errorf("Expected result %d to keep its fingerprint when only the message changes", i)
+		}
+	}
+}
+
+func TestJUnitFormatter(t *testing.T) {
+	results, summary := testResults()
+
+	format := func(summary config.Summary) junitTestSuites {
+		var buf bytes.Buffer
+		if err := (&JUnitFormatter{}).Format(results, summary, &buf); err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+		var report junitTestSuites
+		if err := xml.Unmarshal(buf.Bytes(), &report); err != nil {
+			t.Fatalf("Output is not valid XML: %v\n%s", err, buf.String())
+		}
+		return report
+	}
+
+	report := format(summary)
+	if report.Tests != 3 || report.Failures != 1 {
+		t.Errorf("Expected 3 tests with 1 failure, got %d tests with %d failures", report.Tests, report.Failures)
+	}
+	if len(report.Suites) != 2 || report.Suites[0].Name != "essential-files" {
+		t.Fatalf("Expected one testsuite per agent, got %+v", report.Suites)
+	}
+
+	readme, contributing := report.Suites[0].Cases[0], report.Suites[0].Cases[1]
+	if readme.Name != "readme" || readme.Failure != nil {
+		t.Errorf("Expected the readme check to pass, got %+v", readme)
+	}
+	if contributing.Name != "contributing" || contributing.Failure == nil || contributing.Failure.Type != "missing" ||
+		!strings.Contains(contributing.Failure.Text, "File: CONTRIBUTING.md") {
+		t.Errorf("Expected missing CONTRIBUTING.md to fail the contributing check, got %+v", contributing)
+	}
+
+	// Findings of one check share a testcase named after the check, and
+	// warning-level problems are recorded without failing it.
+	cases := report.Suites[1].Cases
+	if len(cases) != 1 || cases[0].Name != "editorconfig-compliance" {
+		t.Fatalf("Expected one testcase per check, got %+v", cases)
+	}
+	if warning := cases[0]; warning.Failure != nil || warning.SystemOut != "WARNING: main.go:4: trailing whitespace\nWARNING: util.go:9: trailing whitespace" {
+		t.Errorf("Expected warnings to pass with system-out, got %+v", warning)
+	}
+
+	// With --fail-on, problems at or above the threshold fail.
+	summary.FailOn = agents.SeverityWarning
+	report = format(summary)
+	if report.Failures != 2 {
+		t.Errorf("Expected 2 failures with --fail-on warning, got %d", report.Failures)
+	}
+	if failure := report.Suites[1].Cases[0].Failure; failure == nil || !strings.Contains(failure.Text, "File: main.go:4") || !strings.Contains(failure.Text, "File: util.go:9") {
+		t.Errorf("Expected both warnings to fail the check, got %+v", failure)
+	}
+}
+
+func TestMarkdownFormatter(t *testing.T) {
+	results, summary := testResults()
+	results[1].Findings = append(results[1].Findings, agents.Finding{
+		Type:     "invalid",
+		File:     "git-history",
+		Message:  "Commit abc1234 violates format: header does not match 'type(scope): subject' | <b>",
+		Severity: "critical",
+	})
+
+	var buf bytes.Buffer
+	if err := (&MarkdownFormatter{}).Format(results, summary, &buf); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	output := buf.String()
+
+	for _, expected := range []string{
+		"**Overall Score: 0.75 - FAIL (below pass threshold)** (pass: 0.80, warning: 0.60)",
+		"| Essential Files | ✗ FAIL | 0.50 | 1 | 0 |",
+		"| Git Configuration | ✓ PASS | 1.00 | 1 | 2 |",
+		"<summary>✗ Essential Files Agent - FAIL (1 critical, 0 warnings)</summary>",
+		"| ⚠ | warning | `main.go:4` | main.go:4: trailing whitespace |",
+		`subject' \| &lt;b&gt; |`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+
+	if strings.Contains(output, "\x1b[") {
+		t.Error("Expected no ANSI escapes in Markdown output")
+	}
+	if strings.Count(output, "<details>") != 2 || strings.Count(output, "</details>") != 2 {
+		t.Errorf("Expected one details section per agent, got:\n%s", output)
+	}
+}
+
+func TestHTMLFormatter(t *testing.T) {
+	results, summary := testResults()
+	results[0].Findings[1].Message = "CONTRIBUTING.md missing <script>alert(1)</script>"
+
+	var buf bytes.Buffer
+	if err := (&HTMLFormatter{}).Format(results, summary, &buf); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	output := buf.String()
+
+	for _, expected := range []string{
+		"<!DOCTYPE html>",
+		"<style>",
+		`<div class="status warning">FAIL (below pass threshold)</div>`,
+		">75%</text>",
+		`<h2 class="fail">✗ Essential Files</h2>`,
+		`<input type="checkbox" data-severity="warning" checked> warning (2)`,
+		`<tr data-severity="warning">`,
+		`<td class="location">main.go:4</td>`,
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q", expected)
+		}
+	}
+
+	for _, external := range []string{"<link", "src=", "http://", "https://"} {
+		if strings.Contains(output, external) {
+			t.Errorf("Expected a self-contained page, found %q", external)
+		}
+	}
+}
+
+func TestGitHubFormatter(t *testing.T) {
+	results, summary := testResults()
+	results[1].Findings = append(results[1].Findings, agents.Finding{
+		Type:     "invalid",
+		File:     "dir,with:odd%chars",
+		Message:  "100% broken\nsecond line",
+		Severity: "critical",
+	}, agents.Finding{
+		Check:    "commit-messages",
+		Type:     "invalid",
+		File:     agents.FileGitHistory,
+		Line:     3,
+		Message:  "Commit 1a2b3c4 violates allowed_types",
+		Severity: "warning",
+	})
+
+	var buf bytes.Buffer
+	if err := (&GitHubFormatter{}).Format(results, summary, &buf); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"::error title=Essential Files::CONTRIBUTING.md missing",
+		"::warning file=main.go,line=4,title=Git Configuration::main.go:4: trailing whitespace",
+		"::warning file=util.go,line=9,title=Git Configuration::util.go:9: trailing whitespace",
+		"::error file=dir%2Cwith%3Aodd%25chars,title=Git Configuration::100%25 broken%0Asecond line",
+		"::warning title=Git Configuration::Commit 1a2b3c4 violates allowed_types",
+		"::notice title=Codebase validation::Overall Score: 0.75 - FAIL (below pass threshold) (pass: 0.80, warning: 0.60)",
+		"",
+	}, "\n")
+
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestFormatters_AgentError(t *testing.T) {
+	results, summary := testResults()
+	results = append(results, agents.ValidationResult{
+		Agent:    "development-standards",
+		Status:   agents.StatusError,
+		Error:    "failed to check commit history: git log failed",
+		Findings: []agents.Finding{},
+	})
+	summary.Agents = len(results)
+	summary.Errors = 1
+	summary.Passed = false
+
+	tests := []struct {
+		format   string
+		expected []string
+	}{
+		{"table", []string{
+			"! Development Standards Agent - ERROR (Score: 0.0)",
+			"  Error: failed to check commit history: git log failed",
+			"Overall Score: 0.75 - ERROR (1 of 3 agents could not run)",
+		}},
+		{"json", []string{`"status": "error"`, `"error": "failed to check commit history: git log failed"`, `"errors": 1`}},
+		{"markdown", []string{"| Development Standards | ! ERROR | 0.00 | 0 | 0 |", "**Error:** failed to check commit history: git log failed"}},
+		{"html", []string{`<h2 class="error">! Development Standards</h2>`, `<div class="error">Could not run: failed to check commit history: git log failed</div>`}},
+		{"junit", []string{`errors="1"`, `<error message="failed to check commit history: git log failed" type="error"></error>`}},
+		{"sarif", []string{`"executionSuccessful": false`, `"text": "Development Standards Agent could not run: failed to check commit history: git log failed"`}},
+		{"github", []string{"::error title=Development Standards::Development Standards Agent could not run: failed to check commit history: git log failed"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := NewFormatter(tt.format)
+			if err != nil {
+				t.Fatalf("NewFormatter failed: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := formatter.Format(results, summary, &buf); err != nil {
+				t.Fatalf("Format failed: %v", err)
+			}
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(buf.String(), expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, buf.String())
+				}
+			}
+		})
+	}
+}
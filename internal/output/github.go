@@ -0,0 +1,72 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// GitHubFormatter writes GitHub Actions workflow commands, so findings show
+// up as annotations on the pull request. Critical findings are errors,
+// warnings are warnings, and info findings are skipped. Agents that could
+// not run are reported as errors. A closing notice carries the overall score.
+type GitHubFormatter struct{}
+
+var (
+	// Workflow command data only needs '%' and line breaks escaped;
+	// property values also escape the ':' and ',' delimiters.
+	githubDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+func (f *GitHubFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
+	var output strings.Builder
+
+	for _, result := range results {
+		if result.Error != "" {
+			fmt.Fprintf(&output, "::error title=%s::%s\n",
+				githubPropertyEscaper.Replace(agentTitle(result.Agent)),
+				githubDataEscaper.Replace(fmt.Sprintf("%s Agent could not run: %s", agentTitle(result.Agent), result.Error)),
+			)
+		}
+
+		for _, finding := range result.Findings {
+			var command string
+			switch finding.Severity {
+			case "critical":
+				command = "error"
+			case "warning":
+				command = "warning"
+			default:
+				continue
+			}
+
+			// GitHub can only place annotations on files that exist.
+			properties := []string{}
+			if finding.NamesFile() {
+				properties = append(properties, "file="+githubPropertyEscaper.Replace(finding.File))
+				if finding.Line > 0 {
+					properties = append(properties, fmt.Sprintf("line=%d", finding.Line))
+				}
+			}
+			properties = append(properties, "title="+githubPropertyEscaper.Replace(agentTitle(result.Agent)))
+
+			fmt.Fprintf(&output, "::%s %s::%s\n", command, strings.Join(properties, ","), githubDataEscaper.Replace(finding.Message))
+		}
+	}
+
+	statusText, _ := overallStatus(summary)
+	fmt.Fprintf(&output, "::notice title=Codebase validation::%s\n", githubDataEscaper.Replace(fmt.Sprintf(
+		"Overall Score: %.2f - %s (pass: %.2f, warning: %.2f)",
+		summary.Score,
+		statusText,
+		summary.PassThreshold,
+		summary.WarningThreshold,
+	)))
+
+	_, err := io.WriteString(writer, output.String())
+	return err
+}
@@ -0,0 +1,209 @@
+package output
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+
+	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// HTMLFormatter writes a single self-contained HTML page: inline CSS and
+// script, no external assets. It shows an overall score gauge, a section per
+// agent and a findings table that can be filtered by severity.
+type HTMLFormatter struct{}
+
+// gaugeRadius is the radius of the SVG score gauge.
+const gaugeRadius = 54
+
+type htmlReport struct {
+	Summary       config.Summary
+	StatusText    string
+	StatusClass   string
+	Percent       float64
+	Radius        int
+	Circumference float64
+	Dash          float64
+	Agents        []htmlAgent
+	Findings      []htmlFinding
+	Severities    []htmlSeverity
+}
+
+type htmlAgent struct {
+	Title    string
+	Status   string
+	Label    string
+	Score    float64
+	Error    string
+	Critical int
+	Warnings int
+	Findings []htmlFinding
+}
+
+type htmlFinding struct {
+	Agent    string
+	Symbol   string
+	Severity string
+	Location string
+	Message  string
+}
+
+type htmlSeverity struct {
+	Name  string
+	Count int
+}
+
+func (f *HTMLFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
+	statusText, statusClass := overallStatus(summary)
+	circumference := 2 * math.Pi * gaugeRadius
+
+	report := htmlReport{
+		Summary:       summary,
+		StatusText:    statusText,
+		StatusClass:   statusClass,
+		Percent:       summary.Score * 100,
+		Radius:        gaugeRadius,
+		Circumference: circumference,
+		Dash:          circumference * math.Max(0, math.Min(1, summary.Score)),
+	}
+
+	counts := map[string]int{}
+	for _, result := range results {
+		symbol, label := statusLabel(result.Status)
+		critical, warnings := countFindings(result.Findings)
+		agent := htmlAgent{
+			Title:    symbol + " " + agentTitle(result.Agent),
+			Status:   result.Status,
+			Label:    label,
+			Score:    result.Score,
+			Error:    result.Error,
+			Critical: critical,
+			Warnings: warnings,
+		}
+
+		for _, finding := range result.Findings {
+			findingSymbol, _ := findingLabel(finding)
+			location := finding.File
+			if finding.Line > 0 {
+				location = fmt.Sprintf("%s:%d", finding.File, finding.Line)
+			}
+			row := htmlFinding{
+				Agent:    agentTitle(result.Agent),
+				Symbol:   findingSymbol,
+				Severity: finding.Severity,
+				Location: location,
+				Message:  finding.Message,
+			}
+			agent.Findings = append(agent.Findings, row)
+			report.Findings = append(report.Findings, row)
+			counts[finding.Severity]++
+		}
+
+		report.Agents = append(report.Agents, agent)
+	}
+
+	for _, severity := range []string{"critical", "warning", "info"} {
+		report.Severities = append(report.Severities, htmlSeverity{Name: severity, Count: counts[severity]})
+	}
+
+	return htmlTemplate.Execute(writer, report)
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Codebase Validation Report</title>
+<style>
+  :root { --pass: #1a7f37; --warning: #9a6700; --fail: #cf222e; --info: #0969da; --muted: #57606a; --border: #d0d7de; }
+  * { box-sizing: border-box; }
+  body { margin: 0 auto; max-width: 1100px; padding: 2rem; font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2328; }
+  h1 { margin-top: 0; }
+  header { display: flex; align-items: center; gap: 2rem; margin-bottom: 2rem; }
+  .gauge text { font-size: 26px; font-weight: 600; }
+  .status { font-size: 1.4rem; font-weight: 600; }
+  .muted { color: var(--muted); }
+  .pass { color: var(--pass); } .warning { color: var(--warning); } .fail, .error, .critical { color: var(--fail); } .info { color: var(--info); }
+  .gauge .track { stroke: #eaeef2; }
+  .gauge .pass { stroke: var(--pass); } .gauge .warning { stroke: var(--warning); } .gauge .fail { stroke: var(--fail); }
+  .agents { display: grid; grid-template-columns: repeat(auto-fill, minmax(300px, 1fr)); gap: 1rem; margin-bottom: 2rem; }
+  section.agent { border: 1px solid var(--border); border-radius: 6px; padding: 1rem; }
+  section.agent h2 { font-size: 1.1rem; margin: 0 0 .5rem; }
+  section.agent ul { margin: .5rem 0 0; padding-left: 1.2rem; }
+  table { width: 100%; border-collapse: collapse; }
+  th, td { text-align: left; padding: .4rem .6rem; border-bottom: 1px solid var(--border); vertical-align: top; }
+  td.location { font-family: ui-monospace, SFMono-Regular, Menlo, monospace; font-size: .9em; }
+  .filters { margin: 1rem 0; display: flex; gap: 1rem; }
+  .filters label { cursor: pointer; }
+</style>
+</head>
+<body>
+<h1>Codebase Validation Report</h1>
+
+<header>
+  <svg class="gauge" width="140" height="140" viewBox="0 0 140 140" role="img" aria-label="Overall score {{printf "%.2f" .Summary.Score}}">
+    <circle class="track" cx="70" cy="70" r="{{.Radius}}" fill="none" stroke-width="12"></circle>
+    <circle class="{{.StatusClass}}" cx="70" cy="70" r="{{.Radius}}" fill="none" stroke-width="12" stroke-linecap="round"
+      stroke-dasharray="{{printf "%.2f" .Dash}} {{printf "%.2f" .Circumference}}" transform="rotate(-90 70 70)"></circle>
+    <text x="70" y="79" text-anchor="middle">{{printf "%.0f" .Percent}}%</text>
+  </svg>
+  <div>
+    <div class="status {{.StatusClass}}">{{.StatusText}}</div>
+    <div class="muted">Overall score {{printf "%.2f" .Summary.Score}} across {{.Summary.Agents}} agents</div>
+    <div class="muted">Pass threshold {{printf "%.2f" .Summary.PassThreshold}}, warning threshold {{printf "%.2f" .Summary.WarningThreshold}}</div>
+  </div>
+</header>
+
+<div class="agents">
+{{- range .Agents}}
+  <section class="agent">
+    <h2 class="{{.Status}}">{{.Title}}</h2>
+    <div>{{.Label}} &middot; score {{printf "%.2f" .Score}}</div>
+    {{- if .Error}}
+    <div class="error">Could not run: {{.Error}}</div>
+    {{- else}}
+    <div class="muted">{{.Critical}} critical, {{.Warnings}} warnings</div>
+    {{- end}}
+    {{- if .Findings}}
+    <ul>
+      {{- range .Findings}}
+      <li class="{{.Severity}}">{{.Symbol}} {{.Message}}</li>
+      {{- end}}
+    </ul>
+    {{- end}}
+  </section>
+{{- end}}
+</div>
+
+<h2>Findings</h2>
+<div class="filters">
+{{- range .Severities}}
+  <label><input type="checkbox" data-severity="{{.Name}}" checked> {{.Name}} ({{.Count}})</label>
+{{- end}}
+</div>
+<table id="findings">
+  <thead><tr><th></th><th>Severity</th><th>Agent</th><th>File</th><th>Message</th></tr></thead>
+  <tbody>
+  {{- range .Findings}}
+    <tr data-severity="{{.Severity}}"><td class="{{.Severity}}">{{.Symbol}}</td><td>{{.Severity}}</td><td>{{.Agent}}</td><td class="location">{{.Location}}</td><td>{{.Message}}</td></tr>
+  {{- end}}
+  </tbody>
+</table>
+
+<script>
+  document.querySelectorAll(".filters input").forEach(function (box) {
+    box.addEventListener("change", function () {
+      var shown = {};
+      document.querySelectorAll(".filters input").forEach(function (b) { shown[b.dataset.severity] = b.checked; });
+      document.querySelectorAll("#findings tbody tr").forEach(function (row) {
+        row.hidden = shown[row.dataset.severity] === false;
+      });
+    });
+  });
+</script>
+</body>
+</html>
+`))
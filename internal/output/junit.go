@@ -0,0 +1,189 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// JUnitFormatter writes a JUnit XML report with one testsuite per agent and
+// one testcase per check, named after the check so CI test history can
+// follow it from run to run. A check fails when one of its findings is a
+// critical missing or invalid file or setting; with --fail-on, findings at
+// or above that severity fail it instead, so the report agrees with the exit
+// code. Problems that do not fail a check are recorded in system-out. An agent
+// that could not run gets a single testcase with an error.
+type JUnitFormatter struct{}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Properties []junitProperty `xml:"properties>property"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (f *JUnitFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
+	report := junitTestSuites{Name: toolName}
+
+	for _, result := range results {
+		suite := junitTestSuite{
+			Name: result.Agent,
+			Properties: []junitProperty{
+				{Name: "status", Value: result.Status},
+				{Name: "score", Value: fmt.Sprintf("%.2f", result.Score)},
+			},
+		}
+
+		if result.Error != "" {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      agentTitle(result.Agent) + " Agent",
+				Classname: result.Agent,
+				Error: &junitFailure{
+					Message: result.Error,
+					Type:    agents.StatusError,
+				},
+			})
+			suite.Errors++
+		}
+
+		for _, check := range groupByCheck(result.Findings) {
+			testCase := junitTestCase{
+				Name:      check.Name,
+				Classname: result.Agent,
+			}
+			if first := check.Findings[0]; first.NamesFile() {
+				testCase.File = first.File
+				if len(check.Findings) == 1 {
+					testCase.Line = first.Line
+				}
+			}
+
+			var failures, details, warnings []string
+			for _, finding := range check.Findings {
+				switch {
+				case isFailure(finding, summary.FailOn):
+					if testCase.Failure == nil {
+						testCase.Failure = &junitFailure{Message: finding.Message, Type: finding.Type}
+					}
+					failures = append(failures, finding.Message)
+					details = append(details, findingDetails(finding))
+				case finding.IsProblem():
+					warnings = append(warnings, strings.ToUpper(finding.Severity)+": "+finding.Message)
+				}
+			}
+			if testCase.Failure != nil {
+				if len(failures) > 1 {
+					testCase.Failure.Message = fmt.Sprintf("%d findings: %s", len(failures), strings.Join(failures, "; "))
+				}
+				testCase.Failure.Text = strings.Join(details, "\n\n")
+				suite.Failures++
+			}
+			testCase.SystemOut = strings.Join(warnings, "\n")
+
+			suite.Cases = append(suite.Cases, testCase)
+		}
+
+		suite.Tests = len(suite.Cases)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Suites = append(report.Suites, suite)
+	}
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(writer, "\n")
+	return err
+}
+
+// isFailure reports whether a finding fails its check: a missing or invalid
+// file or setting at least as severe as failOn, or critical when it is not
+// set.
+func isFailure(finding agents.Finding, failOn string) bool {
+	if failOn == "" {
+		failOn = agents.SeverityCritical
+	}
+	return finding.ProblemAtLeast(failOn)
+}
+
+// findingGroup holds the findings of one check.
+type findingGroup struct {
+	Name     string
+	Findings []agents.Finding
+}
+
+// groupByCheck groups findings by check, in the order the checks first
+// appear. Findings without a check are grouped by file.
+func groupByCheck(findings []agents.Finding) []findingGroup {
+	var groups []findingGroup
+	index := map[string]int{}
+	for _, finding := range findings {
+		name := finding.Check
+		if name == "" {
+			name = finding.File
+		}
+		i, exists := index[name]
+		if !exists {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, findingGroup{Name: name})
+		}
+		groups[i].Findings = append(groups[i].Findings, finding)
+	}
+	return groups
+}
+
+func findingDetails(finding agents.Finding) string {
+	var details []string
+	if finding.File != "" {
+		location := finding.File
+		if finding.Line > 0 {
+			location = fmt.Sprintf("%s:%d", finding.File, finding.Line)
+		}
+		details = append(details, "File: "+location)
+	}
+	details = append(details, "Severity: "+finding.Severity)
+	return strings.Join(details, "\n")
+}
@@ -0,0 +1,119 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+// MarkdownFormatter writes a GitHub-flavored Markdown report without ANSI
+// escapes, suitable for job summaries and PR comments: a summary table of
+// agents followed by a collapsible section of findings per agent.
+type MarkdownFormatter struct{}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"<", "&lt;",
+	">", "&gt;",
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+func (f *MarkdownFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
+	var output strings.Builder
+
+	statusText, _ := overallStatus(summary)
+	output.WriteString("## Codebase Validation\n\n")
+	fmt.Fprintf(&output, "**Overall Score: %.2f - %s** (pass: %.2f, warning: %.2f)\n\n",
+		summary.Score,
+		statusText,
+		summary.PassThreshold,
+		summary.WarningThreshold,
+	)
+
+	output.WriteString("| Agent | Status | Score | Critical | Warnings |\n")
+	output.WriteString("|-------|--------|------:|---------:|---------:|\n")
+	for _, result := range results {
+		symbol, label := statusLabel(result.Status)
+		critical, warnings := countFindings(result.Findings)
+		fmt.Fprintf(&output, "| %s | %s %s | %.2f | %d | %d |\n",
+			agentTitle(result.Agent),
+			symbol,
+			label,
+			result.Score,
+			critical,
+			warnings,
+		)
+	}
+
+	for _, result := range results {
+		symbol, label := statusLabel(result.Status)
+		critical, warnings := countFindings(result.Findings)
+
+		output.WriteString("\n<details>\n")
+		fmt.Fprintf(&output, "<summary>%s %s Agent - %s (%d critical, %d warnings)</summary>\n\n",
+			symbol,
+			agentTitle(result.Agent),
+			label,
+			critical,
+			warnings,
+		)
+
+		if result.Error != "" {
+			fmt.Fprintf(&output, "**Error:** %s\n", markdownEscaper.Replace(result.Error))
+		} else if len(result.Findings) == 0 {
+			output.WriteString("No findings.\n")
+		} else {
+			output.WriteString("| | Severity | File | Message |\n")
+			output.WriteString("|-|----------|------|---------|\n")
+			for _, finding := range result.Findings {
+				findingSymbol, _ := findingLabel(finding)
+				fmt.Fprintf(&output, "| %s | %s | %s | %s |\n",
+					findingSymbol,
+					finding.Severity,
+					markdownLocation(finding),
+					markdownEscaper.Replace(finding.Message),
+				)
+			}
+		}
+
+		output.WriteString("\n</details>\n")
+	}
+
+	_, err := io.WriteString(writer, output.String())
+	return err
+}
+
+// countFindings returns the number of failing critical findings and of
+// warnings.
+func countFindings(findings []agents.Finding) (int, int) {
+	critical, warnings := 0, 0
+	for _, finding := range findings {
+		switch {
+		case finding.IsProblem() && finding.Severity == agents.SeverityCritical:
+			critical++
+		case finding.Severity == "warning":
+			warnings++
+		}
+	}
+	return critical, warnings
+}
+
+func markdownLocation(finding agents.Finding) string {
+	if finding.File == "" {
+		return ""
+	}
+	location := finding.File
+	if finding.Line > 0 {
+		location = fmt.Sprintf("%s:%d", finding.File, finding.Line)
+	}
+	// Inside a code span only the pipe needs escaping for the table.
+	return "`" + strings.ReplaceAll(strings.ReplaceAll(location, "`", "'"), "|", `\|`) + "`"
+}
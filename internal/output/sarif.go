@@ -0,0 +1,267 @@
+package output
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/codebase-interface/cli/internal/agents"
+	"github.com/codebase-interface/cli/internal/config"
+)
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName       = "codebase-interface"
+	toolURI        = "https://github.com/codebase-interface/cli"
+)
+
+// sarifRuleDescriptions describes the rule generated for each check. Checks
+// with a parameter, such as "custom-file:LICENSE*", are looked up by the part
+// before the colon.
+var sarifRuleDescriptions = map[string]struct{ Name, Description string }{
+	"readme":                     {"Readme", "The project has a README"},
+	"readme-min-lines":           {"ReadmeLength", "The README meets the minimum length"},
+	"readme-description":         {"ReadmeDescription", "The README describes the project"},
+	"readme-installation":        {"ReadmeInstallation", "The README has an installation section"},
+	"readme-usage":               {"ReadmeUsage", "The README has a usage section"},
+	"readme-badges":              {"ReadmeBadges", "The README shows status badges"},
+	"contributing":               {"Contributing", "The project has a CONTRIBUTING.md"},
+	"custom-file":                {"CustomFile", "A file listed in custom_files is present"},
+	"docs-directory":             {"DocsDirectory", "The documentation directory exists"},
+	"docs-min-files":             {"DocsFileCount", "The documentation directory holds enough files"},
+	"docs-usage-guide":           {"DocsUsageGuide", "The documentation includes a usage guide"},
+	"docs-examples":              {"DocsExamples", "The project has an examples directory"},
+	"gitignore":                  {"Gitignore", "The project has a .gitignore"},
+	"gitignore-patterns":         {"GitignorePatterns", "The .gitignore covers the patterns for a language"},
+	"gitignore-tracked-files":    {"GitignoreTrackedFiles", "No tracked file matches the .gitignore rules"},
+	"gitattributes":              {"Gitattributes", "The project has a .gitattributes"},
+	"gitattributes-line-endings": {"GitattributesLineEndings", "The .gitattributes normalizes line endings"},
+	"gitattributes-binary-files": {"GitattributesBinaryFiles", "Binary files are marked binary or stored with LFS"},
+	"gitattributes-eol":          {"GitattributesEOL", "Tracked files have the line endings .gitattributes declares"},
+	"editorconfig":               {"Editorconfig", "The project has an .editorconfig"},
+	"editorconfig-syntax":        {"EditorconfigSyntax", "The .editorconfig is valid"},
+	"editorconfig-compliance":    {"EditorconfigCompliance", "Files comply with the .editorconfig"},
+	"conventional-commits":       {"ConventionalCommits", "Recent commits follow conventional commit rules"},
+	"commit-messages":            {"CommitMessages", "Each analysed commit message follows the configured rules"},
+	"branch-naming":              {"BranchNaming", "The branch name follows the naming conventions"},
+}
+
+// sarifLevelRank orders SARIF levels from least to most severe.
+var sarifLevelRank = map[string]int{"note": 1, "warning": 2, "error": 3}
+
+type SARIFFormatter struct{}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations"`
+	Results     []sarifResult     `json:"results"`
+	Properties  *config.Summary   `json:"properties,omitempty"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
+type sarifTool struct {
+	Driver     sarifToolComponent   `json:"driver"`
+	Extensions []sarifToolComponent `json:"extensions,omitempty"`
+}
+
+type sarifToolComponent struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name,omitempty"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Rule                sarifRuleRef      `json:"rule"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+}
+
+type sarifRuleRef struct {
+	ID            string                `json:"id"`
+	Index         int                   `json:"index"`
+	ToolComponent sarifToolComponentRef `json:"toolComponent"`
+}
+
+type sarifToolComponentRef struct {
+	Name  string `json:"name"`
+	Index int    `json:"index"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func (f *SARIFFormatter) Format(results []agents.ValidationResult, summary config.Summary, writer io.Writer) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifToolComponent{
+				Name:           toolName,
+				InformationURI: toolURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results:    []sarifResult{},
+		Properties: &summary,
+	}
+	invocation := sarifInvocation{ExecutionSuccessful: true}
+	occurrences := map[string]int{}
+
+	for componentIndex, result := range results {
+		component := sarifToolComponent{Name: result.Agent, Rules: []sarifRule{}}
+		ruleIndex := map[string]int{}
+
+		if result.Error != "" {
+			invocation.ExecutionSuccessful = false
+			invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, sarifNotification{
+				Level:   "error",
+				Message: sarifMessage{Text: agentTitle(result.Agent) + " Agent could not run: " + result.Error},
+			})
+		}
+
+		for _, finding := range result.Findings {
+			if finding.Severity == "info" {
+				continue
+			}
+
+			check := finding.Check
+			if check == "" {
+				check = finding.Type
+			}
+			ruleID := result.Agent + "/" + check
+			level := sarifLevel(finding.Severity)
+
+			index, exists := ruleIndex[ruleID]
+			if !exists {
+				index = len(component.Rules)
+				ruleIndex[ruleID] = index
+				name, _, _ := strings.Cut(check, ":")
+				description := sarifRuleDescriptions[name]
+				if description.Description == "" {
+					description.Description = "Validation check " + check
+				}
+				component.Rules = append(component.Rules, sarifRule{
+					ID:                   ruleID,
+					Name:                 description.Name,
+					ShortDescription:     sarifMessage{Text: description.Description},
+					DefaultConfiguration: sarifRuleConfiguration{Level: level},
+				})
+			} else if rule := &component.Rules[index]; sarifLevelRank[level] > sarifLevelRank[rule.DefaultConfiguration.Level] {
+				rule.DefaultConfiguration.Level = level
+			}
+
+			sarif := sarifResult{
+				RuleID: ruleID,
+				Rule: sarifRuleRef{
+					ID:            ruleID,
+					Index:         index,
+					ToolComponent: sarifToolComponentRef{Name: result.Agent, Index: componentIndex},
+				},
+				Level:   level,
+				Message: sarifMessage{Text: finding.Message},
+				PartialFingerprints: map[string]string{
+					"findingHash/v1": findingFingerprint(ruleID, finding, occurrences),
+				},
+			}
+
+			if finding.NamesFile() {
+				location := sarifLocation{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: finding.File, URIBaseID: "%SRCROOT%"},
+					},
+				}
+				if finding.Line > 0 {
+					location.PhysicalLocation.Region = &sarifRegion{StartLine: finding.Line}
+				}
+				sarif.Locations = []sarifLocation{location}
+			}
+
+			run.Results = append(run.Results, sarif)
+		}
+
+		run.Tool.Extensions = append(run.Tool.Extensions, component)
+	}
+	run.Invocations = []sarifInvocation{invocation}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
+
+// sarifLevel maps a finding severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch severity {
+	case "critical":
+		return "error"
+	case "warning":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// findingFingerprint identifies a finding across runs by its rule and
+// location only, as messages include counts and commit hashes that change
+// from run to run. Like GitHub's own line hashes, it ends in a counter that
+// tells apart findings sharing a rule and location; occurrences tracks the
+// counters across a log.
+func findingFingerprint(ruleID string, finding agents.Finding, occurrences map[string]int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%d", ruleID, finding.File, finding.Line)))
+	hash := hex.EncodeToString(sum[:])
+	occurrences[hash]++
+	return fmt.Sprintf("%s:%d", hash, occurrences[hash])
+}